@@ -0,0 +1,45 @@
+// Package aptos
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package aptos
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Aptos account addresses are the authentication key of a single ed25519 signer, SHA3-256(public key || scheme)
+// https://aptos.dev/en/network/blockchain/accounts
+const SchemeEd25519 byte = 0x00
+
+// Network implements bip44.Ed25519Chain for Aptos accounts, every network shares the encoding
+type Network struct{}
+
+// AuthenticationKey returns SHA3-256(public key || 0x00)
+func AuthenticationKey(pubKey ed25519.PublicKey) []byte {
+	h := sha3.New256()
+	h.Write(pubKey)
+	h.Write([]byte{SchemeEd25519})
+	return h.Sum(nil)
+}
+
+func (Network) Label() string {
+	return "Address(SHA3-256)"
+}
+
+// Address returns the 0x prefixed hex account address of the public key
+func (Network) Address(pubKey ed25519.PublicKey) (string, error) {
+	return fmt.Sprintf("0x%x", AuthenticationKey(pubKey)), nil
+}
+
+// PrivateKey returns the 32-byte private key as 0x prefixed hex, the format the Aptos CLI imports
+func (Network) PrivateKey(prvKey ed25519.PrivateKey) (string, error) {
+	return fmt.Sprintf("0x%x", prvKey.Seed()), nil
+}
+
+func (Network) PrivateKeyFormat() string {
+	return "Private Key(hex)"
+}
@@ -0,0 +1,58 @@
+package aptos
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"testing"
+
+	"github.com/tyler-smith/go-bip39"
+
+	"key-gen/slip10"
+)
+
+// TestAddress checks the ed25519 wallet vector of the Aptos TypeScript SDK at m/44'/637'/0'/0'/0'
+// https://github.com/aptos-labs/aptos-ts-sdk/blob/main/tests/unit/helper.ts
+func TestAddress(t *testing.T) {
+	tests := []struct {
+		mnemonic   string
+		privateKey string
+		publicKey  string
+		address    string
+	}{
+		{
+			"shoot island position soft burden budget tooth cruel issue economy destroy above",
+			"0x5d996aa76b3212142792d9130796cd2e11e3c445a93118c08414df4f66bc60ec",
+			"0xea526ba1710343d953461ff68641f1b7df5f23b9042ffa2d2a798d3adb3f3d6c",
+			"0x07968dab936c1bad187c60ce4082f307d030d780e91e694ae03aef16aba73f30",
+		},
+	}
+	for _, test := range tests {
+		key := slip10.NewMasterKey(bip39.NewSeed(test.mnemonic, ""))
+		for _, index := range []uint32{44, 637, 0, 0, 0} {
+			var err error
+			key, err = key.NewChildKey(slip10.FirstHardenedIndex + index)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		prvKey := ed25519.NewKeyFromSeed(key.Key)
+		privateKey, err := Network{}.PrivateKey(prvKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if privateKey != test.privateKey {
+			t.Errorf("PrivateKey() = %s, want %s", privateKey, test.privateKey)
+		}
+		pubKey := prvKey.Public().(ed25519.PublicKey)
+		if got := fmt.Sprintf("0x%x", []byte(pubKey)); got != test.publicKey {
+			t.Errorf("public key = %s, want %s", got, test.publicKey)
+		}
+		address, err := Network{}.Address(pubKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if address != test.address {
+			t.Errorf("Address() = %s, want %s", address, test.address)
+		}
+	}
+}
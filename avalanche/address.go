@@ -0,0 +1,98 @@
+// Package avalanche
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package avalanche
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/tyler-smith/go-bip32"
+
+	"key-gen/btc"
+)
+
+// X-chain and P-chain addresses are the chain alias, a dash and the bech32 encoding of RIPEMD160(SHA256(compressed public key))
+// The same key controls an address on both chains, the C-chain uses the EVM address of the key
+// https://docs.avax.network/reference/standards/cryptography
+const (
+	ChainX = "X"
+	ChainP = "P"
+)
+
+// Network holds the bech32 HRP of an Avalanche network
+// Its bip44.Chain addresses are on the X-chain, the bip44.SecondaryAddresser ones on the P-chain
+type Network struct {
+	Name string
+	HRP  string
+}
+
+var (
+	Mainnet = &Network{"mainnet", "avax"}
+	Testnet = &Network{"fuji", "fuji"}
+)
+
+// CB58Encode returns base58 of the data followed by the last 4 bytes of its SHA-256
+func CB58Encode(b []byte) string {
+	sum := sha256.Sum256(b)
+	return base58.Encode(append(append([]byte{}, b...), sum[28:]...))
+}
+
+// EncodeAddress returns the address of the public key on the chain with the given alias, e.g. X-avax1...
+func (n *Network) EncodeAddress(chainAlias string, pubKey *btcec.PublicKey) (string, error) {
+	address, err := bech32.EncodeFromBase256(n.HRP, btcutil.Hash160(pubKey.SerializeCompressed()))
+	if err != nil {
+		return "", err
+	}
+	return chainAlias + "-" + address, nil
+}
+
+func (n *Network) Supports(addressType btc.AddressType) bool {
+	return addressType == btc.AddressTypeP2PKH
+}
+
+// ChainLabel returns the name of the address encoding on the chain with the given alias
+func ChainLabel(chainAlias string) string {
+	return chainAlias + "-Chain(bech32)"
+}
+
+// Label returns the name of the X-chain address encoding
+func (n *Network) Label(addressType btc.AddressType, compress bool) string {
+	return ChainLabel(ChainX)
+}
+
+// Address returns the X-chain address of the public key
+func (n *Network) Address(pubKey *btcec.PublicKey, compress bool, addressType btc.AddressType) (string, error) {
+	if !n.Supports(addressType) {
+		return "", fmt.Errorf("%s is not supported on Avalanche", addressType)
+	}
+	return n.EncodeAddress(ChainX, pubKey)
+}
+
+// SecondaryLabel returns the name of the P-chain address encoding
+func (n *Network) SecondaryLabel(compress bool) string {
+	return ChainLabel(ChainP)
+}
+
+// SecondaryAddress returns the P-chain address of the public key
+func (n *Network) SecondaryAddress(pubKey *btcec.PublicKey, compress bool) (string, error) {
+	return n.EncodeAddress(ChainP, pubKey)
+}
+
+// PrivateKey returns the private key in the PrivateKey-<CB58> format the Avalanche wallet and avalanchego import
+func (n *Network) PrivateKey(prvKey *btcec.PrivateKey, compress bool) (string, error) {
+	return "PrivateKey-" + CB58Encode(prvKey.Serialize()), nil
+}
+
+func (n *Network) PrivateKeyFormat() string {
+	return "Private Key(CB58)"
+}
+
+func (n *Network) ExtendedPublicKey(key *bip32.Key, addressType btc.AddressType) string {
+	return ""
+}
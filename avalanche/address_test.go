@@ -0,0 +1,61 @@
+package avalanche
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/base58"
+
+	"key-gen/btc"
+)
+
+// TestEncodeAddress checks the ewoq key funded in every avalanchego local network
+// https://github.com/ava-labs/avalanchego/blob/master/genesis/genesis_local.go
+func TestEncodeAddress(t *testing.T) {
+	secretKey := "PrivateKey-ewoqjP7PxY4yr3iLTpLisriqt94hdyDFNgchSxGGztUrTXtNN"
+	b := base58.Decode(secretKey[len("PrivateKey-"):])
+	prvKey, pubKey := btcec.PrivKeyFromBytes(b[:len(b)-4])
+	if got, _ := Mainnet.PrivateKey(prvKey, true); got != secretKey {
+		t.Errorf("PrivateKey() = %s, want %s", got, secretKey)
+	}
+
+	tests := []struct {
+		network    *Network
+		chainAlias string
+		want       string
+	}{
+		{&Network{"local", "local"}, ChainX, "X-local18jma8ppw3nhx5r4ap8clazz0dps7rv5u00z96u"},
+		{&Network{"custom", "custom"}, ChainX, "X-custom18jma8ppw3nhx5r4ap8clazz0dps7rv5u9xde7p"},
+		{Mainnet, ChainX, "X-avax18jma8ppw3nhx5r4ap8clazz0dps7rv5ukulre5"},
+		{Mainnet, ChainP, "P-avax18jma8ppw3nhx5r4ap8clazz0dps7rv5ukulre5"},
+		{Testnet, ChainP, "P-fuji18jma8ppw3nhx5r4ap8clazz0dps7rv5u6wmu4t"},
+	}
+	for _, test := range tests {
+		got, err := test.network.EncodeAddress(test.chainAlias, pubKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%s EncodeAddress(%s) = %s, want %s", test.network.Name, test.chainAlias, got, test.want)
+		}
+	}
+}
+
+// TestSecondaryAddress checks that the X-chain and P-chain addresses of a key share their bech32 part
+func TestSecondaryAddress(t *testing.T) {
+	_, pubKey := btcec.PrivKeyFromBytes([]byte{1})
+	x, err := Mainnet.Address(pubKey, true, btc.AddressTypeP2PKH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := Mainnet.SecondaryAddress(pubKey, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x[:2] != "X-" || p[:2] != "P-" || x[2:] != p[2:] {
+		t.Errorf("Address() = %s, SecondaryAddress() = %s, want the same address on the X and P chains", x, p)
+	}
+	if _, err := Mainnet.Address(pubKey, true, btc.AddressTypeP2WPKH); err == nil {
+		t.Error("Address(P2WPKH) error = nil, want unsupported")
+	}
+}
@@ -0,0 +1,120 @@
+// Package bch
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// CashAddr : Address format for Bitcoin Cash
+// https://github.com/bitcoincashorg/bitcoincash.org/blob/master/spec/cashaddr.md
+const cashAddrCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// Version bytes of 160 bit hashes, the type in bits 3-6 and the hash size in bits 0-2
+const (
+	TypeP2PKH byte = 0x00 // q... addresses
+	TypeP2SH  byte = 0x08 // p... addresses
+)
+
+var cashAddrGenerator = [5]uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+
+func cashAddrPolymod(values []byte) uint64 {
+	c := uint64(1)
+	for _, d := range values {
+		c0 := byte(c >> 35)
+		c = ((c & 0x07ffffffff) << 5) ^ uint64(d)
+		for i := 0; i < 5; i++ {
+			if (c0>>i)&1 == 1 {
+				c ^= cashAddrGenerator[i]
+			}
+		}
+	}
+	return c ^ 1
+}
+
+// convertBits regroups 8 bit bytes into padded 5 bit groups
+func convertBits(data []byte) []byte {
+	groups := make([]byte, 0, (len(data)*8+4)/5)
+	acc, bits := uint32(0), uint(0)
+	for _, b := range data {
+		acc = acc<<8 | uint32(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			groups = append(groups, byte(acc>>bits)&31)
+		}
+	}
+	if bits > 0 {
+		groups = append(groups, byte(acc<<(5-bits))&31)
+	}
+	return groups
+}
+
+// Address is a CashAddr address of a 160 bit hash
+type Address struct {
+	Prefix  string
+	Version byte
+	Hash    []byte
+}
+
+// NewAddressPubKeyHash returns the P2PKH address of a public key hash
+func NewAddressPubKeyHash(pubKeyHash []byte, prefix string) (*Address, error) {
+	return newAddress(pubKeyHash, prefix, TypeP2PKH)
+}
+
+// NewAddressScriptHash returns the P2SH address of a serialized redeem script
+func NewAddressScriptHash(serializedScript []byte, prefix string) (*Address, error) {
+	return newAddress(btcutil.Hash160(serializedScript), prefix, TypeP2SH)
+}
+
+// NewAddressScriptHashFromHash returns the P2SH address of a redeem script hash
+func NewAddressScriptHashFromHash(scriptHash []byte, prefix string) (*Address, error) {
+	return newAddress(scriptHash, prefix, TypeP2SH)
+}
+
+func newAddress(hash []byte, prefix string, version byte) (*Address, error) {
+	if len(hash) != 20 {
+		return nil, fmt.Errorf("hash must be 20 bytes, got %d", len(hash))
+	}
+	return &Address{Prefix: strings.ToLower(prefix), Version: version, Hash: hash}, nil
+}
+
+// EncodeAddress returns the prefix-less form of the address, e.g. qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a
+func (a *Address) EncodeAddress() string {
+	return Encode(a.Prefix, append([]byte{a.Version}, a.Hash...))
+}
+
+// Encode returns the prefix-less CashAddr encoding of a version byte followed by a payload
+// Other chains with CashAddr style addresses reuse it with their own prefix
+func Encode(prefix string, data []byte) string {
+	payload := convertBits(data)
+
+	// The checksum covers the lower 5 bits of every prefix character, a zero separator and the payload
+	values := make([]byte, 0, len(prefix)+1+len(payload)+8)
+	for _, c := range []byte(prefix) {
+		values = append(values, c&31)
+	}
+	values = append(values, 0)
+	values = append(values, payload...)
+	values = append(values, 0, 0, 0, 0, 0, 0, 0, 0)
+	checksum := cashAddrPolymod(values)
+
+	var sb strings.Builder
+	for _, d := range payload {
+		sb.WriteByte(cashAddrCharset[d])
+	}
+	for i := 0; i < 8; i++ {
+		sb.WriteByte(cashAddrCharset[(checksum>>(5*(7-i)))&31])
+	}
+	return sb.String()
+}
+
+// String returns the address with its prefix, e.g. bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a
+func (a *Address) String() string {
+	return a.Prefix + ":" + a.EncodeAddress()
+}
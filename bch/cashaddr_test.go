@@ -0,0 +1,55 @@
+package bch
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// TestEncode checks the 160 bit hash vectors of the CashAddr specification
+// https://github.com/bitcoincashorg/bitcoincash.org/blob/master/spec/cashaddr.md#examples-of-address-translation
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		prefix  string
+		version byte
+		hash    string
+		address string
+	}{
+		{"bitcoincash", TypeP2PKH, "f5bf48b397dae70be82b3cca4793f8eb2b6cdac9", "qr6m7j9njldwwzlg9v7v53unlr4jkmx6eylep8ekg2"},
+		{"bchtest", TypeP2SH, "f5bf48b397dae70be82b3cca4793f8eb2b6cdac9", "pr6m7j9njldwwzlg9v7v53unlr4jkmx6eyvwc0uz5t"},
+		{"pref", TypeP2SH, "f5bf48b397dae70be82b3cca4793f8eb2b6cdac9", "pr6m7j9njldwwzlg9v7v53unlr4jkmx6ey65nvtks5"},
+		{"bitcoincash", TypeP2PKH, "76a04053bda0a88bda5177b86a15c3b29f559873", "qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"},
+	}
+	for _, test := range tests {
+		t.Run(test.prefix+":"+test.address, func(t *testing.T) {
+			hash, _ := hex.DecodeString(test.hash)
+			if address := Encode(test.prefix, append([]byte{test.version}, hash...)); address != test.address {
+				t.Errorf("Encode() = %s, want %s", address, test.address)
+			}
+		})
+	}
+}
+
+func TestAddress(t *testing.T) {
+	hash, _ := hex.DecodeString("76a04053bda0a88bda5177b86a15c3b29f559873")
+	address, err := NewAddressPubKeyHash(hash, "BitcoinCash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := address.EncodeAddress(), "qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"; got != want {
+		t.Errorf("EncodeAddress() = %s, want %s", got, want)
+	}
+	if got, want := address.String(), "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"; got != want {
+		t.Errorf("String() = %s, want %s", got, want)
+	}
+	scriptHash, _ := hex.DecodeString("f5bf48b397dae70be82b3cca4793f8eb2b6cdac9")
+	p2sh, err := NewAddressScriptHashFromHash(scriptHash, "bchtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := p2sh.String(), "bchtest:pr6m7j9njldwwzlg9v7v53unlr4jkmx6eyvwc0uz5t"; got != want {
+		t.Errorf("P2SH String() = %s, want %s", got, want)
+	}
+	if _, err := NewAddressPubKeyHash(hash[:19], "bitcoincash"); err == nil {
+		t.Error("NewAddressPubKeyHash accepted a 19 byte hash")
+	}
+}
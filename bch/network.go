@@ -0,0 +1,76 @@
+// Package bch
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bch
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+
+	"key-gen/btc"
+)
+
+// Network is the bip44.Chain of Bitcoin Cash, its keys share the Bitcoin WIF and extended key versions
+// Bitcoin Cash never activated SegWit, so only P2PKH addresses are derived, with and without their prefix
+type Network struct {
+	*btc.Network
+	Prefix string
+}
+
+var (
+	Mainnet = &Network{btc.Mainnet, "bitcoincash"}
+	Testnet = &Network{btc.Testnet, "bchtest"}
+)
+
+// Supports reports whether the chain can spend the address type
+func (n *Network) Supports(addressType btc.AddressType) bool {
+	return addressType == btc.AddressTypeP2PKH
+}
+
+// Label returns the label of the address type, noting whether the key is compressed
+func (n *Network) Label(addressType btc.AddressType, compress bool) string {
+	if compress {
+		return "CashAddr(P2PKH, compressed)"
+	}
+	return "CashAddr(P2PKH, uncompressed)"
+}
+
+// Address returns the CashAddr address with its prefix paying to the public key
+func (n *Network) Address(pubKey *btcec.PublicKey, compress bool, addressType btc.AddressType) (string, error) {
+	if !n.Supports(addressType) {
+		return "", fmt.Errorf("%s is not supported on Bitcoin Cash", addressType)
+	}
+	address, err := n.pubKeyHashAddress(pubKey, compress)
+	if err != nil {
+		return "", err
+	}
+	return address.String(), nil
+}
+
+// SecondaryLabel returns the label of the prefix-less address, noting whether the key is compressed
+func (n *Network) SecondaryLabel(compress bool) string {
+	if compress {
+		return "CashAddr(P2PKH, compressed, no prefix)"
+	}
+	return "CashAddr(P2PKH, uncompressed, no prefix)"
+}
+
+// SecondaryAddress returns the P2PKH address without its prefix, the form most Bitcoin Cash wallets display
+func (n *Network) SecondaryAddress(pubKey *btcec.PublicKey, compress bool) (string, error) {
+	address, err := n.pubKeyHashAddress(pubKey, compress)
+	if err != nil {
+		return "", err
+	}
+	return address.EncodeAddress(), nil
+}
+
+func (n *Network) pubKeyHashAddress(pubKey *btcec.PublicKey, compress bool) (*Address, error) {
+	serializedPubKey := pubKey.SerializeUncompressed()
+	if compress {
+		serializedPubKey = pubKey.SerializeCompressed()
+	}
+	return NewAddressPubKeyHash(btcutil.Hash160(serializedPubKey), n.Prefix)
+}
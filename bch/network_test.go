@@ -0,0 +1,63 @@
+package bch
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/tyler-smith/go-bip32"
+	"github.com/tyler-smith/go-bip39"
+
+	"key-gen/btc"
+)
+
+// TestNetworkAddress checks the first Bitcoin Cash address of the abandon ... about mnemonic at m/44'/145'/0'/0/0, with and without its prefix
+func TestNetworkAddress(t *testing.T) {
+	key, err := bip32.NewMasterKey(bip39.NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range []uint32{bip32.FirstHardenedChild + 44, bip32.FirstHardenedChild + 145, bip32.FirstHardenedChild, 0, 0} {
+		key, err = key.NewChildKey(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	pubKey, err := btcec.ParsePubKey(key.PublicKey().Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address, err := Mainnet.Address(pubKey, true, btc.AddressTypeP2PKH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "bitcoincash:qqyx49mu0kkn9ftfj6hje6g2wfer34yfnq5tahq3q6"; address != want {
+		t.Errorf("address = %s, want %s", address, want)
+	}
+	tests := []struct {
+		compress bool
+		label    string
+	}{
+		{true, "CashAddr(P2PKH, compressed, no prefix)"},
+		{false, "CashAddr(P2PKH, uncompressed, no prefix)"},
+	}
+	for _, test := range tests {
+		prefixed, err := Mainnet.Address(pubKey, test.compress, btc.AddressTypeP2PKH)
+		if err != nil {
+			t.Fatal(err)
+		}
+		secondary, err := Mainnet.SecondaryAddress(pubKey, test.compress)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if "bitcoincash:"+secondary != prefixed {
+			t.Errorf("compress %v: SecondaryAddress() = %s, want %s without its prefix", test.compress, secondary, prefixed)
+		}
+		if label := Mainnet.SecondaryLabel(test.compress); label != test.label {
+			t.Errorf("compress %v: SecondaryLabel() = %s, want %s", test.compress, label, test.label)
+		}
+	}
+	if _, err := Mainnet.Address(pubKey, true, btc.AddressTypeP2WPKH); err == nil {
+		t.Error("P2WPKH address derived on Bitcoin Cash")
+	}
+}
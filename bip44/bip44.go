@@ -7,13 +7,15 @@ Thanks to modood and tyler-smith for the reference implementation.
 package bip44
 
 import (
-	"encoding/json"
 	"fmt"
-	"strings"
 	"sync"
 
 	"github.com/tyler-smith/go-bip32"
 	"github.com/tyler-smith/go-bip39"
+
+	"key-gen/btc"
+	"key-gen/cardano"
+	"key-gen/slip10"
 )
 
 // Purpose BIP43 - Purpose Field for Deterministic Wallets
@@ -40,6 +42,25 @@ const (
 	PurposeBIP86 Purpose = 0x80000056 // 86' BIP86
 )
 
+// Purposes lists the purposes generated by default
+var Purposes = []Purpose{PurposeBIP44, PurposeBIP49, PurposeBIP84, PurposeBIP86}
+
+// AddressType returns the only address type a purpose defines
+// BIP44 yields P2PKH, BIP49 P2SH-P2WPKH, BIP84 P2WPKH and BIP86 P2TR
+func (p Purpose) AddressType() (btc.AddressType, error) {
+	switch p {
+	case PurposeBIP44:
+		return btc.AddressTypeP2PKH, nil
+	case PurposeBIP49:
+		return btc.AddressTypeP2SHP2WPKH, nil
+	case PurposeBIP84:
+		return btc.AddressTypeP2WPKH, nil
+	case PurposeBIP86:
+		return btc.AddressTypeP2TR, nil
+	}
+	return 0, fmt.Errorf("unsupported purpose %d'", uint32(p)-Apostrophe)
+}
+
 // CoinType SLIP-0044 : Registered coin types for BIP-0044
 // https://github.com/satoshilabs/slips/blob/master/slip-0044.md
 type CoinType uint32
@@ -55,32 +76,48 @@ const (
 	CoinTypeBitcoinCash     CoinType = 0x80000091 // 145' Bitcoin Cash
 	CoinTypeBitcoinSV       CoinType = 0x800000c9 // 201' Bitcoin SV
 	CoinTypeLitecoinTestnet CoinType = 0x80000004 // 4' Litecoin Testnet
-	CoinTypeDashTestnet     CoinType = 0x80000006 // 6' Dash Testnet
 	CoinTypeBitcoinGold     CoinType = 0x8000009c // 156' Bitcoin Gold
 	CoinTypeZcash           CoinType = 0x80000085 // 133' Zcash
-	CoinTypeZcashTestnet    CoinType = 0x80000087 // 133' Zcash Testnet
-	CoinTypeRavencoin       CoinType = 0x8000007a // 122' Ravencoin
-	CoinTypeMonacoin        CoinType = 0x80000080 // 128' Monacoin
+	CoinTypeZcashTestnet    CoinType = 0x80000001 // 1' Zcash Testnet
+	CoinTypeRavencoin       CoinType = 0x800000af // 175' Ravencoin
+	CoinTypeMonacoin        CoinType = 0x80000016 // 22' Monacoin
 	CoinTypeDecred          CoinType = 0x8000002a // 42' Decred
-	CoinTypeGroestlcoin     CoinType = 0x800000c0 // 192' Groestlcoin
+	CoinTypeGroestlcoin     CoinType = 0x80000011 // 17' Groestlcoin
 	CoinTypeDigiByte        CoinType = 0x80000014 // 20' DigiByte
-	CoinTypeQtum            CoinType = 0x8000002b // 43' Qtum
-	CoinTypeViacoin         CoinType = 0x80000070 // 112' Viacoin
+	CoinTypeQtum            CoinType = 0x800008fd // 2301' Qtum
+	CoinTypeViacoin         CoinType = 0x8000000e // 14' Viacoin
 	CoinTypeBitcoinPrivate  CoinType = 0x800000cc // 204' Bitcoin Private
 	CoinTypeBitcoinZ        CoinType = 0x800000b6 // 182' BitcoinZ
-	CoinTypeHush            CoinType = 0x80000085 // 133' Hush
 	CoinTypeZelcash         CoinType = 0x800000b8 // 184' Zelcash
 	CoinTypeSnowGem         CoinType = 0x800000b7 // 183' SnowGem
 	CoinTypeBitcore         CoinType = 0x8000000d // 13' Bitcore
 	CoinTypeZenCash         CoinType = 0x80000020 // 32' ZenCash
-	CoinTypePeercoin        CoinType = 0x80000066 // 102' Peercoin
+	CoinTypePeercoin        CoinType = 0x80000006 // 6' Peercoin
+	CoinTypeTron            CoinType = 0x800000c3 // 195' Tron
+	CoinTypeXRP             CoinType = 0x80000090 // 144' XRP
+	CoinTypeSolana          CoinType = 0x800001f5 // 501' Solana
+	CoinTypeStellar         CoinType = 0x80000094 // 148' Stellar
+	CoinTypeAptos           CoinType = 0x8000027d // 637' Aptos
+	CoinTypeSui             CoinType = 0x80000310 // 784' Sui
+	CoinTypeFilecoin        CoinType = 0x800001cd // 461' Filecoin
+	CoinTypeTezos           CoinType = 0x800006c1 // 1729' Tezos
+	CoinTypeAvalanche       CoinType = 0x80002328 // 9000' Avalanche
+	CoinTypeKaspa           CoinType = 0x8001b207 // 111111' Kaspa
+	CoinTypeCardano         CoinType = 0x80000717 // 1815' Cardano
 	CoinTypeBitcoinAtom     CoinType = 0x8000009a // 154' Bitcoin Atom
 	CoinTypeBitcoinInterest CoinType = 0x800000ce // 206' Bitcoin Interest
 	CoinTypeBitcoinGreen    CoinType = 0x8000008c // 140' Bitcoin Green
 	CoinTypeBitcoinPlus     CoinType = 0x80000066 // 102' Bitcoin Plus
-	CoinTypeBitcoinDark     CoinType = 0x8000000e // 14' Bitcoin Dark
 )
 
+// NetworkCoinType returns the coin type of a Bitcoin network, every test network shares 1'
+func NetworkCoinType(network *btc.Network) CoinType {
+	if network == btc.Mainnet {
+		return CoinTypeBitcoin
+	}
+	return CoinTypeTestnet
+}
+
 const Apostrophe uint32 = 0x80000000 // 0'
 
 // https://github.com/bitcoin/bips/blob/master/bip-0044.mediawiki
@@ -90,10 +127,12 @@ const Apostrophe uint32 = 0x80000000 // 0'
 // BIP44
 
 type KeyManager struct {
-	Mnemonic   string
-	Passphrase string
-	keys       map[string]*bip32.Key
-	mux        sync.Mutex
+	Mnemonic    string
+	Passphrase  string
+	keys        map[string]*bip32.Key
+	ed25519Keys map[string]*slip10.Key
+	cardanoKeys map[string]*cardano.Key
+	mux         sync.Mutex
 }
 
 // NewKeyManager return new key manager
@@ -111,9 +150,11 @@ func NewKeyManager(mnemonic, passphrase string) (*KeyManager, error) {
 	}
 
 	km := &KeyManager{
-		Mnemonic:   mnemonic,
-		Passphrase: passphrase,
-		keys:       make(map[string]*bip32.Key, 0),
+		Mnemonic:    mnemonic,
+		Passphrase:  passphrase,
+		keys:        make(map[string]*bip32.Key, 0),
+		ed25519Keys: make(map[string]*slip10.Key, 0),
+		cardanoKeys: make(map[string]*cardano.Key, 0),
 	}
 	return km, nil
 }
@@ -169,7 +210,7 @@ func (km *KeyManager) PurposeKey(purpose Purpose) (*Key, error) {
 		return nil, err
 	}
 
-	key, err = parent.BIP32Key.NewChildKey(uint32(purpose))
+	key, err = deriveChild(parent.BIP32Key, uint32(purpose))
 	if err != nil {
 		return nil, err
 	}
@@ -193,7 +234,7 @@ func (km *KeyManager) CoinTypeKey(purpose Purpose, coinType CoinType) (*Key, err
 		return nil, err
 	}
 
-	key, err = parent.BIP32Key.NewChildKey(uint32(coinType))
+	key, err = deriveChild(parent.BIP32Key, uint32(coinType))
 	if err != nil {
 		return nil, err
 	}
@@ -203,9 +244,12 @@ func (km *KeyManager) CoinTypeKey(purpose Purpose, coinType CoinType) (*Key, err
 	return NewKey(path, key), nil
 }
 
-// AccountKey returns the account key
-func (km *KeyManager) AccountKey(purpose Purpose, coinType CoinType, account uint32) (*Key, error) {
-	path := fmt.Sprintf("m/%d'/%d'/%d'", uint32(purpose)-Apostrophe, uint32(coinType)-Apostrophe, account)
+// AccountKey returns the account key, the account level is always hardened
+func (km *KeyManager) AccountKey(purpose Purpose, coinType CoinType, account HardenedIndex) (*Key, error) {
+	if err := account.Validate(); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("m/%d'/%d'/%s", uint32(purpose)-Apostrophe, uint32(coinType)-Apostrophe, account)
 
 	key, ok := km.GetKey(path)
 	if ok {
@@ -217,7 +261,7 @@ func (km *KeyManager) AccountKey(purpose Purpose, coinType CoinType, account uin
 		return nil, err
 	}
 
-	key, err = parent.BIP32Key.NewChildKey(account)
+	key, err = deriveChild(parent.BIP32Key, account.ChildIndex())
 	if err != nil {
 		return nil, err
 	}
@@ -231,8 +275,11 @@ func (km *KeyManager) AccountKey(purpose Purpose, coinType CoinType, account uin
 // https://github.com/bitcoin/bips/blob/master/bip-0044.mediawiki#change
 // change constant 0 is used for external chain
 // change constant 1 is used for internal chain (also known as change addresses)
-func (km *KeyManager) ChangeKey(purpose Purpose, coinType CoinType, account uint32, change uint32) (*Key, error) {
-	path := fmt.Sprintf("m/%d'/%d'/%d'/%d", uint32(purpose)-Apostrophe, uint32(coinType)-Apostrophe, account, change)
+func (km *KeyManager) ChangeKey(purpose Purpose, coinType CoinType, account HardenedIndex, change Index) (*Key, error) {
+	if err := change.Validate(); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("m/%d'/%d'/%s/%s", uint32(purpose)-Apostrophe, uint32(coinType)-Apostrophe, account, change)
 
 	key, ok := km.GetKey(path)
 	if ok {
@@ -244,7 +291,7 @@ func (km *KeyManager) ChangeKey(purpose Purpose, coinType CoinType, account uint
 		return nil, err
 	}
 
-	key, err = parent.BIP32Key.NewChildKey(change)
+	key, err = deriveChild(parent.BIP32Key, change.ChildIndex())
 	if err != nil {
 		return nil, err
 	}
@@ -255,8 +302,11 @@ func (km *KeyManager) ChangeKey(purpose Purpose, coinType CoinType, account uint
 }
 
 // Key returns the key for the given path
-func (km *KeyManager) Key(purpose Purpose, coinType CoinType, account uint32, change uint32, index uint32) (*Key, error) {
-	path := fmt.Sprintf(`m/%d'/%d'/%d'/%d/%d`, uint32(purpose)-Apostrophe, uint32(coinType)-Apostrophe, account, change, index)
+func (km *KeyManager) Key(purpose Purpose, coinType CoinType, account HardenedIndex, change Index, index Index) (*Key, error) {
+	if err := index.Validate(); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf(`m/%d'/%d'/%s/%s/%s`, uint32(purpose)-Apostrophe, uint32(coinType)-Apostrophe, account, change, index)
 
 	key, ok := km.GetKey(path)
 	if ok {
@@ -268,7 +318,7 @@ func (km *KeyManager) Key(purpose Purpose, coinType CoinType, account uint32, ch
 		return nil, err
 	}
 
-	key, err = parent.BIP32Key.NewChildKey(index)
+	key, err = deriveChild(parent.BIP32Key, index.ChildIndex())
 	if err != nil {
 		return nil, err
 	}
@@ -278,228 +328,31 @@ func (km *KeyManager) Key(purpose Purpose, coinType CoinType, account uint32, ch
 	return NewKey(path, key), nil
 }
 
-type KeyAccountJSON struct {
-	Path       string `json:"path"`
-	Address    string `json:"address"`
-	PrivateKey string `json:"private_key"`
-	KeyType    string `json:"type"`
-}
-
-type KeyManagerJSON struct {
-	Mnemonic        string           `json:"recovery_phrase"`
-	Passphrase      string           `json:"mnemonic_password"`
-	Seed            string           `json:"seed"`
-	RootKey         string           `json:"root_key"`
-	EVMAccounts     []KeyAccountJSON `json:"evm_accounts"`
-	BitcoinAccounts []KeyAccountJSON `json:"bitcoin_accounts"`
-}
-
-// ToJSON returns the key manager as a JSON string
-func (km *KeyManager) ToJSON(accounts int, compress bool) (string, error) {
-	mainKey, err := km.MainKey()
-	if err != nil {
-		return "", err
-	}
-	btcAccounts := make([]KeyAccountJSON, 0)
-	evmAccounts := make([]KeyAccountJSON, 0)
-	evmAccounts = append(evmAccounts, KeyAccountJSON{
-		Path:       mainKey.Path,
-		Address:    mainKey.EVMAddress.String(),
-		PrivateKey: fmt.Sprintf("%x", mainKey.Key),
-		KeyType:    "Ethereum(EIP55)",
-	})
-	mainWIF, err := mainKey.NewWIF(compress)
-	if err != nil {
-		return "", err
-	}
-	btcAccounts = append(btcAccounts, KeyAccountJSON{
-		Path:       mainKey.Path,
-		Address:    mainWIF.Address,
-		PrivateKey: mainWIF.WIFString,
-		KeyType:    "Legacy(P2PKH, compressed)",
-	})
-	for i := 0; i < accounts; i++ {
-		legacyKey, err := km.Key(PurposeBIP44, CoinTypeBitcoin, 0, 0, uint32(i))
-		if err != nil {
-			return "", err
-		}
-		legacy, err := legacyKey.NewWIF(compress)
-		if err != nil {
-			return "", err
-		}
-		btcAccounts = append(btcAccounts, KeyAccountJSON{
-			Path:       legacyKey.Path,
-			Address:    legacy.Address,
-			PrivateKey: legacy.WIFString,
-			KeyType:    "Legacy(P2PKH, compressed)",
-		})
-		swnKey, err := km.Key(PurposeBIP49, CoinTypeBitcoin, 0, 0, uint32(i))
-		if err != nil {
-			return "", err
-		}
-		swn, err := swnKey.NewWIF(compress)
-		if err != nil {
-			return "", err
-		}
-		btcAccounts = append(btcAccounts, KeyAccountJSON{
-			Path:       swnKey.Path,
-			Address:    swn.Address,
-			PrivateKey: swn.WIFString,
-			KeyType:    "SegWit(P2WPKH-nested-in-P2SH)",
-		})
-		swn32Key, err := km.Key(PurposeBIP84, CoinTypeBitcoin, 0, 0, uint32(i))
-		if err != nil {
-			return "", err
-		}
-		swn32, err := swn32Key.NewWIF(compress)
-		if err != nil {
-			return "", err
-		}
-		btcAccounts = append(btcAccounts, KeyAccountJSON{
-			Path:       swn32Key.Path,
-			Address:    swn32.Address,
-			PrivateKey: swn32.WIFString,
-			KeyType:    "SegWit(P2WPKH, bech32)",
-		})
-		tprKey, err := km.Key(PurposeBIP86, CoinTypeBitcoin, 0, 0, uint32(i))
-		if err != nil {
-			return "", err
-		}
-		tpr, err := tprKey.NewWIF(compress)
-		if err != nil {
-			return "", err
-		}
-		btcAccounts = append(btcAccounts, KeyAccountJSON{
-			Path:       tprKey.Path,
-			Address:    tpr.Address,
-			PrivateKey: tpr.WIFString,
-			KeyType:    "Taproot(P2TR, bech32m)",
-		})
-		key, err := km.Key(PurposeBIP44, CoinTypeEthereum, 0, 0, uint32(i))
-		if err != nil {
-			return "", err
-		}
-		evmAccounts = append(evmAccounts, KeyAccountJSON{
-			Path:       key.Path,
-			Address:    key.EVMAddress.String(),
-			PrivateKey: fmt.Sprintf("%x", key.Key),
-			KeyType:    "Ethereum(EIP55)",
-		})
-	}
-	kmj := &KeyManagerJSON{
-		Mnemonic:        km.Mnemonic,
-		Passphrase:      km.Passphrase,
-		Seed:            fmt.Sprintf("%x", km.Seed()),
-		RootKey:         mainKey.Base58Key(),
-		BitcoinAccounts: btcAccounts,
-		EVMAccounts:     evmAccounts,
-	}
-	b, err := json.Marshal(kmj)
-	if err != nil {
-		return "", err
-	}
-	return string(b), nil
-}
-
-func (km *KeyManager) ToPrettyString(accounts int, compress bool) (sp string, err error) {
-	sp = ""
-	mainKey, err := km.MainKey()
+// DeriveKeyByPath returns the key for an arbitrary BIP32 path string such as m/0'/0'/5' or m/44h/501h/0h/0h
+// Every intermediate level is cached, so paths sharing a prefix with the BIP44 ladder reuse the same keys
+func (km *KeyManager) DeriveKeyByPath(path string) (*Key, error) {
+	indices, err := ParsePath(path)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	passphrase := km.Passphrase
-	if passphrase == "" {
-		passphrase = "<none>"
-	}
-	sp += strings.Repeat("-", 200)
-	sp += fmt.Sprintf("\n%-18s %s\n", "BIP39 Mnemonic:", km.Mnemonic)
-	sp += fmt.Sprintf("%-18s %s\n", "BIP39 Passphrase:", passphrase)
-	sp += fmt.Sprintf("%-18s %x\n", "BIP39 Seed:", km.Seed())
-	sp += fmt.Sprintf("%-18s %s\n", "BIP32 Root BIP32Key:", mainKey.Base58Key())
-
-	sp += fmt.Sprintf("\n%-18s %-34s %-52s\n", "Path(BIP44)", "Legacy(P2PKH, compressed)", "WIF(Wallet Import Format)")
-	sp += strings.Repeat("-", 106)
-	sp += "\n"
-	wif, err := mainKey.NewWIF(compress)
+	key, err := km.MainKey()
 	if err != nil {
-		return "", err
-	}
-	sp += fmt.Sprintf("%-18s %-34s %s\n", mainKey.Path, wif.Address, wif.WIFString)
-
-	for i := 0; i < accounts; i++ {
-		key, err := km.Key(PurposeBIP44, CoinTypeBitcoin, 0, 0, uint32(i))
-		if err != nil {
-			return "", err
-		}
-		wif, err := key.NewWIF(compress)
-		if err != nil {
-			return "", err
-		}
-
-		sp += fmt.Sprintf("%-18s %-34s %s\n", key.Path, wif.Address, wif.WIFString)
-	}
-
-	sp += fmt.Sprintf("\n%-18s %-34s %s\n", "Path(BIP49)", "SegWit(P2WPKH-nested-in-P2SH)", "WIF(Wallet Import Format)")
-	sp += strings.Repeat("-", 106)
-	sp += "\n"
-	for i := 0; i < accounts; i++ {
-		key, err := km.Key(PurposeBIP49, CoinTypeBitcoin, 0, 0, uint32(i))
-		if err != nil {
-			return "", err
-		}
-		wif, err := key.NewWIF(compress)
-		if err != nil {
-			return "", err
-		}
-
-		sp += fmt.Sprintf("%-18s %s %s\n", key.Path, wif.SegwitNested, wif.WIFString)
-	}
-
-	sp += fmt.Sprintf("\n%-18s %-42s %s\n", "Path(BIP84)", "SegWit(P2WPKH, bech32)", "WIF(Wallet Import Format)")
-	sp += strings.Repeat("-", 114)
-	sp += "\n"
-	for i := 0; i < accounts; i++ {
-		key, err := km.Key(PurposeBIP84, CoinTypeBitcoin, 0, 0, uint32(i))
-		if err != nil {
-			return "", err
-		}
-		dwif, err := key.NewWIF(compress)
-		if err != nil {
-			return "", err
-		}
-
-		sp += fmt.Sprintf("%-18s %s %s\n", key.Path, dwif.SegwitBech32, dwif.WIFString)
+		return nil, err
 	}
 
-	sp += fmt.Sprintf("\n%-18s %-62s %s\n", "Path(BIP86)", "Taproot(P2TR, bech32m)", "WIF(Wallet Import Format)")
-	sp += strings.Repeat("-", 134)
-	sp += "\n"
-
-	for i := 0; i < accounts; i++ {
-		key, err := km.Key(PurposeBIP86, CoinTypeBitcoin, 0, 0, uint32(i))
-		if err != nil {
-			return "", err
-		}
-		wif, err := key.NewWIF(compress)
-		if err != nil {
-			return "", err
+	for i, index := range indices {
+		childPath := FormatPath(indices[:i+1])
+		child, ok := km.GetKey(childPath)
+		if !ok {
+			child, err = deriveChild(key.BIP32Key, index)
+			if err != nil {
+				return nil, err
+			}
+			km.SetKey(childPath, child)
 		}
-
-		sp += fmt.Sprintf("%-18s %s %s\n", key.Path, wif.Taproot, wif.WIFString)
+		key = NewKey(childPath, child)
 	}
 
-	sp += fmt.Sprintf("\n%-18s %-42s %-52s\n", "Path(BIP44)", "Ethereum(EIP55)", "Private BIP32Key(hex)")
-	sp += strings.Repeat("-", 126)
-	sp += "\n"
-	sp += fmt.Sprintf("%-18s %s %x\n", mainKey.Path, mainKey.EVMAddress, mainKey.Key)
-	for i := 0; i < accounts; i++ {
-		key, err := km.Key(PurposeBIP44, CoinTypeEthereum, 0, 0, uint32(i))
-		if err != nil {
-			return "", err
-		}
-		sp += fmt.Sprintf("%-18s %s %x\n", key.Path, key.EVMAddress, key.Key)
-	}
-	sp += "\n"
-	return sp, nil
+	return key, nil
 }
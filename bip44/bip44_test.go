@@ -0,0 +1,48 @@
+package bip44
+
+import (
+	"testing"
+
+	"key-gen/btc"
+)
+
+func TestPurposeAddressType(t *testing.T) {
+	tests := []struct {
+		purpose     Purpose
+		addressType btc.AddressType
+		wantErr     bool
+	}{
+		{PurposeBIP44, btc.AddressTypeP2PKH, false},
+		{PurposeBIP49, btc.AddressTypeP2SHP2WPKH, false},
+		{PurposeBIP84, btc.AddressTypeP2WPKH, false},
+		{PurposeBIP86, btc.AddressTypeP2TR, false},
+		{Purpose(Apostrophe + 45), 0, true},
+		{Purpose(44), 0, true},
+	}
+	for _, test := range tests {
+		addressType, err := test.purpose.AddressType()
+		if (err != nil) != test.wantErr {
+			t.Errorf("Purpose(%#x).AddressType() error = %v, wantErr %v", uint32(test.purpose), err, test.wantErr)
+		}
+		if addressType != test.addressType {
+			t.Errorf("Purpose(%#x).AddressType() = %s, want %s", uint32(test.purpose), addressType, test.addressType)
+		}
+	}
+}
+
+func TestNetworkCoinType(t *testing.T) {
+	tests := []struct {
+		network  *btc.Network
+		coinType CoinType
+	}{
+		{btc.Mainnet, CoinTypeBitcoin},
+		{btc.Testnet, CoinTypeTestnet},
+		{btc.Signet, CoinTypeTestnet},
+		{btc.Regtest, CoinTypeTestnet},
+	}
+	for _, test := range tests {
+		if coinType := NetworkCoinType(test.network); coinType != test.coinType {
+			t.Errorf("NetworkCoinType(%s) = %d', want %d'", test.network.Name, uint32(coinType)-Apostrophe, uint32(test.coinType)-Apostrophe)
+		}
+	}
+}
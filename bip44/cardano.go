@@ -0,0 +1,164 @@
+// Package bip44
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bip44
+
+import (
+	"fmt"
+
+	"github.com/tyler-smith/go-bip39"
+
+	"key-gen/cardano"
+)
+
+// CIP-1852 : HD Wallets for Cardano
+// https://github.com/cardano-foundation/CIPs/blob/master/CIP-1852/README.md
+// m / 1852' / 1815' / account' / role / index, role 0 is external, 1 internal and 2 the stake key
+const (
+	PurposeCIP1852 uint32 = 0x8000073c // 1852'
+	RoleStaking    uint32 = 2
+)
+
+// CardanoKey is an Icarus BIP32-Ed25519 key and the path it was derived at
+type CardanoKey struct {
+	Path      string
+	IcarusKey *cardano.Key
+}
+
+// getCardanoKey returns the Icarus key for the given path
+func (km *KeyManager) getCardanoKey(path string) (*cardano.Key, bool) {
+	km.mux.Lock()
+	defer km.mux.Unlock()
+
+	key, ok := km.cardanoKeys[path]
+	return key, ok
+}
+
+// setCardanoKey sets the Icarus key for the given path
+func (km *KeyManager) setCardanoKey(path string, key *cardano.Key) {
+	km.mux.Lock()
+	defer km.mux.Unlock()
+
+	km.cardanoKeys[path] = key
+}
+
+// CardanoMainKey returns the Icarus master key, which is derived from the mnemonic entropy and passphrase rather than the seed
+func (km *KeyManager) CardanoMainKey() (*CardanoKey, error) {
+	path := "m"
+	key, ok := km.getCardanoKey(path)
+	if ok {
+		return &CardanoKey{path, key}, nil
+	}
+	entropy, err := bip39.EntropyFromMnemonic(km.Mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	key = cardano.NewMasterKey(entropy, km.Passphrase)
+	km.setCardanoKey(path, key)
+	return &CardanoKey{path, key}, nil
+}
+
+// CardanoKeyByPath returns the Icarus key for a path such as m/1852'/1815'/0'/0/0
+func (km *KeyManager) CardanoKeyByPath(path string) (*CardanoKey, error) {
+	indices, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := km.CardanoMainKey()
+	if err != nil {
+		return nil, err
+	}
+	for i, index := range indices {
+		childPath := FormatPath(indices[:i+1])
+		child, ok := km.getCardanoKey(childPath)
+		if !ok {
+			child = key.IcarusKey.NewChildKey(index)
+			km.setCardanoKey(childPath, child)
+		}
+		key = &CardanoKey{childPath, child}
+	}
+	return key, nil
+}
+
+// cardanoAccountPath returns the CIP-1852 path of the selected account
+func cardanoAccountPath(opts ExportOptions) string {
+	return fmt.Sprintf("m/%d'/%d'/%s", PurposeCIP1852-Apostrophe, uint32(CoinTypeCardano)-Apostrophe, opts.Account)
+}
+
+// cardanoDeriver exports Cardano accounts from the Icarus keys of the mnemonic
+type cardanoDeriver struct{}
+
+// Accounts returns the base addresses of the selected account, change roles and indices followed by the stake address
+// Every base address delegates to the account's first stake key, as Shelley wallets do
+func (cardanoDeriver) Accounts(km *KeyManager, opts ExportOptions) ([]KeyAccountJSON, error) {
+	network := cardano.Mainnet
+	accountPath := cardanoAccountPath(opts)
+	stakeKey, err := km.CardanoKeyByPath(fmt.Sprintf("%s/%d/0", accountPath, RoleStaking))
+	if err != nil {
+		return nil, err
+	}
+	stakePublicKey := stakeKey.IcarusKey.PublicKey()
+
+	accounts := make([]KeyAccountJSON, 0)
+	for _, pair := range opts.indices() {
+		key, err := km.CardanoKeyByPath(fmt.Sprintf("%s/%s/%s", accountPath, pair[0], pair[1]))
+		if err != nil {
+			return nil, err
+		}
+		address, err := network.BaseAddress(key.IcarusKey.PublicKey(), stakePublicKey)
+		if err != nil {
+			return nil, err
+		}
+		signingKey, err := cardano.SigningKey("addr_xsk", key.IcarusKey)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, KeyAccountJSON{
+			Path:       key.Path,
+			Address:    address,
+			PrivateKey: signingKey,
+			KeyType:    "Base(addr)",
+		})
+	}
+
+	stakeAddress, err := network.StakeAddress(stakePublicKey)
+	if err != nil {
+		return nil, err
+	}
+	stakeSigningKey, err := cardano.SigningKey("stake_xsk", stakeKey.IcarusKey)
+	if err != nil {
+		return nil, err
+	}
+	accounts = append(accounts, KeyAccountJSON{
+		Path:       stakeKey.Path,
+		Address:    stakeAddress,
+		PrivateKey: stakeSigningKey,
+		KeyType:    "Stake(reward)",
+	})
+	return accounts, nil
+}
+
+// ExtendedKey returns the account extended verification key, acct_xvk1...
+// It has no key origin, the Icarus master key is not the BIP32 master key the fingerprint is taken from
+func (cardanoDeriver) ExtendedKey(km *KeyManager, opts ExportOptions) (ExtendedKeyJSON, error) {
+	key, err := km.CardanoKeyByPath(cardanoAccountPath(opts))
+	if err != nil {
+		return ExtendedKeyJSON{}, err
+	}
+	xvk, err := cardano.AccountXVK(key.IcarusKey)
+	if err != nil {
+		return ExtendedKeyJSON{}, err
+	}
+	return ExtendedKeyJSON{
+		Path:      key.Path,
+		PublicKey: xvk,
+		KeyType:   "Cardano acct_xvk(CIP-1852)",
+	}, nil
+}
+
+// PrivateKeyFormat returns the label of the extended signing keys
+func (cardanoDeriver) PrivateKeyFormat() string {
+	return "Extended Signing Key(bech32)"
+}
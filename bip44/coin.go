@@ -0,0 +1,187 @@
+// Package bip44
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bip44
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/tyler-smith/go-bip32"
+
+	"key-gen/aptos"
+	"key-gen/avalanche"
+	"key-gen/bch"
+	"key-gen/btc"
+	"key-gen/dcr"
+	"key-gen/filecoin"
+	"key-gen/grs"
+	"key-gen/kaspa"
+	"key-gen/solana"
+	"key-gen/stellar"
+	"key-gen/sui"
+	"key-gen/tezos"
+	"key-gen/tron"
+	"key-gen/xrp"
+	"key-gen/zec"
+)
+
+// Chain encodes the addresses, private keys and extended public keys of a secp256k1 coin derived with BIP32
+// Each purpose of the path selects an address type, chains report which ones they can spend
+type Chain interface {
+	Supports(addressType btc.AddressType) bool               // chains with a single address type derive it on BIP44
+	Label(addressType btc.AddressType, compress bool) string // name of the address encoding
+	Address(pubKey *btcec.PublicKey, compress bool, addressType btc.AddressType) (string, error)
+	PrivateKey(prvKey *btcec.PrivateKey, compress bool) (string, error)   // in the format the chain's wallets import
+	ExtendedPublicKey(key *bip32.Key, addressType btc.AddressType) string // empty when the chain has no extended key encoding
+}
+
+// PrivateKeyFormatter is implemented by chains whose private keys are not WIFs, e.g. hex for Tron
+type PrivateKeyFormatter interface {
+	PrivateKeyFormat() string // label of the private key encoding
+}
+
+// Validator is implemented by chains that restrict the public key serialization, e.g. Decred only encodes compressed keys
+type Validator interface {
+	Validate(compress bool) error
+}
+
+// Coin is a chain derived on m / purpose' / coin_type' / account' / change / address_index
+// ed25519 coins set Ed25519 instead of Chain and derive with SLIP-0010 on their Ed25519Path, whose %d is the index
+// Tezos sets both, tz1 accounts are ed25519 and tz2 accounts secp256k1
+// Cardano sets Deriver instead, it has its own Icarus derivation and addresses
+type Coin struct {
+	Symbol          string
+	Name            string
+	CoinType        CoinType
+	Chain           Chain
+	TestnetCoinType CoinType
+	Testnet         Chain // nil when the coin has no test network
+	Ed25519         Ed25519Chain
+	Ed25519Path     string
+	Deriver         AccountDeriver
+}
+
+// AccountDeriver exports the accounts of a coin with its own derivation scheme from the shared mnemonic
+type AccountDeriver interface {
+	Accounts(km *KeyManager, opts ExportOptions) ([]KeyAccountJSON, error)
+	ExtendedKey(km *KeyManager, opts ExportOptions) (ExtendedKeyJSON, error)
+	PrivateKeyFormat() string
+}
+
+var (
+	CoinBitcoin     = &Coin{Symbol: "btc", Name: "Bitcoin", CoinType: CoinTypeBitcoin, Chain: btc.Mainnet, TestnetCoinType: CoinTypeTestnet, Testnet: btc.Testnet}
+	CoinLitecoin    = &Coin{Symbol: "ltc", Name: "Litecoin", CoinType: CoinTypeLitecoin, Chain: btc.Litecoin}
+	CoinDogecoin    = &Coin{Symbol: "doge", Name: "Dogecoin", CoinType: CoinTypeDogecoin, Chain: btc.Dogecoin}
+	CoinDash        = &Coin{Symbol: "dash", Name: "Dash", CoinType: CoinTypeDash, Chain: btc.Dash}
+	CoinDigiByte    = &Coin{Symbol: "dgb", Name: "DigiByte", CoinType: CoinTypeDigiByte, Chain: btc.DigiByte}
+	CoinRavencoin   = &Coin{Symbol: "rvn", Name: "Ravencoin", CoinType: CoinTypeRavencoin, Chain: btc.Ravencoin}
+	CoinPeercoin    = &Coin{Symbol: "ppc", Name: "Peercoin", CoinType: CoinTypePeercoin, Chain: btc.Peercoin}
+	CoinViacoin     = &Coin{Symbol: "via", Name: "Viacoin", CoinType: CoinTypeViacoin, Chain: btc.Viacoin}
+	CoinMonacoin    = &Coin{Symbol: "mona", Name: "Monacoin", CoinType: CoinTypeMonacoin, Chain: btc.Monacoin}
+	CoinQtum        = &Coin{Symbol: "qtum", Name: "Qtum", CoinType: CoinTypeQtum, Chain: btc.Qtum}
+	CoinBitcoinGold = &Coin{Symbol: "btg", Name: "Bitcoin Gold", CoinType: CoinTypeBitcoinGold, Chain: btc.BitcoinGold}
+	CoinBitcoinCash = &Coin{Symbol: "bch", Name: "Bitcoin Cash", CoinType: CoinTypeBitcoinCash, Chain: bch.Mainnet, TestnetCoinType: CoinTypeTestnet, Testnet: bch.Testnet}
+	CoinDecred      = &Coin{Symbol: "dcr", Name: "Decred", CoinType: CoinTypeDecred, Chain: dcr.Mainnet, TestnetCoinType: CoinTypeTestnet, Testnet: dcr.Testnet}
+	CoinGroestlcoin = &Coin{Symbol: "grs", Name: "Groestlcoin", CoinType: CoinTypeGroestlcoin, Chain: grs.Mainnet, TestnetCoinType: CoinTypeTestnet, Testnet: grs.Testnet}
+	CoinZcash       = &Coin{Symbol: "zec", Name: "Zcash", CoinType: CoinTypeZcash, Chain: zec.Mainnet, TestnetCoinType: CoinTypeZcashTestnet, Testnet: zec.Testnet}
+	CoinTron        = &Coin{Symbol: "trx", Name: "Tron", CoinType: CoinTypeTron, Chain: tron.Mainnet}
+	CoinXRP         = &Coin{Symbol: "xrp", Name: "XRP", CoinType: CoinTypeXRP, Chain: xrp.Mainnet, TestnetCoinType: CoinTypeXRP, Testnet: xrp.Testnet}
+	CoinAvalanche   = &Coin{Symbol: "avax", Name: "Avalanche", CoinType: CoinTypeAvalanche, Chain: avalanche.Mainnet, TestnetCoinType: CoinTypeAvalanche, Testnet: avalanche.Testnet}
+	CoinKaspa       = &Coin{Symbol: "kas", Name: "Kaspa", CoinType: CoinTypeKaspa, Chain: kaspa.Mainnet, TestnetCoinType: CoinTypeKaspa, Testnet: kaspa.Testnet}
+	CoinFilecoin    = &Coin{Symbol: "fil", Name: "Filecoin", CoinType: CoinTypeFilecoin, Chain: filecoin.Mainnet, TestnetCoinType: CoinTypeTestnet, Testnet: filecoin.Testnet}
+	CoinCardano     = &Coin{Symbol: "ada", Name: "Cardano", CoinType: CoinTypeCardano, Deriver: cardanoDeriver{}}
+	CoinSolana      = &Coin{Symbol: "sol", Name: "Solana", CoinType: CoinTypeSolana, Ed25519: solana.Network{}, Ed25519Path: "m/44'/501'/%d'/0'"}
+	CoinStellar     = &Coin{Symbol: "xlm", Name: "Stellar", CoinType: CoinTypeStellar, Ed25519: stellar.Network{}, Ed25519Path: "m/44'/148'/%d'"}
+	CoinAptos       = &Coin{Symbol: "apt", Name: "Aptos", CoinType: CoinTypeAptos, Ed25519: aptos.Network{}, Ed25519Path: "m/44'/637'/%d'/0'/0'"}
+	CoinSui         = &Coin{Symbol: "sui", Name: "Sui", CoinType: CoinTypeSui, Ed25519: sui.Network{}, Ed25519Path: "m/44'/784'/%d'/0'/0'"}
+	CoinTezos       = &Coin{Symbol: "xtz", Name: "Tezos", CoinType: CoinTypeTezos, Chain: tezos.Tz2, Ed25519: tezos.Tz1, Ed25519Path: "m/44'/1729'/%d'/0'"}
+)
+
+// Coins lists every coin that can be selected by its symbol
+var Coins = []*Coin{
+	CoinBitcoin,
+	CoinLitecoin,
+	CoinDogecoin,
+	CoinDash,
+	CoinDigiByte,
+	CoinRavencoin,
+	CoinPeercoin,
+	CoinViacoin,
+	CoinMonacoin,
+	CoinQtum,
+	CoinBitcoinGold,
+	CoinBitcoinCash,
+	CoinZcash,
+	CoinDecred,
+	CoinGroestlcoin,
+	CoinTron,
+	CoinXRP,
+	CoinFilecoin,
+	CoinAvalanche,
+	CoinKaspa,
+	CoinSolana,
+	CoinStellar,
+	CoinAptos,
+	CoinSui,
+	CoinTezos,
+	CoinCardano,
+}
+
+// ParseCoin returns the coin with the given symbol, e.g. ltc
+func ParseCoin(symbol string) (*Coin, error) {
+	for _, coin := range Coins {
+		if strings.EqualFold(coin.Symbol, symbol) {
+			return coin, nil
+		}
+	}
+	symbols := make([]string, 0, len(Coins))
+	for _, coin := range Coins {
+		symbols = append(symbols, coin.Symbol)
+	}
+	return nil, fmt.Errorf("invalid coin %q, expected one of %s", symbol, strings.Join(symbols, ", "))
+}
+
+// PrivateKeyFormat returns the label of the coin's private key encoding, WIF unless the chain says otherwise
+func (c *Coin) PrivateKeyFormat() string {
+	if c.Ed25519 != nil {
+		return c.Ed25519.PrivateKeyFormat()
+	}
+	if c.Deriver != nil {
+		return c.Deriver.PrivateKeyFormat()
+	}
+	if formatter, ok := c.Chain.(PrivateKeyFormatter); ok {
+		return formatter.PrivateKeyFormat()
+	}
+	return "WIF(Wallet Import Format)"
+}
+
+// Purposes returns the purposes of the selection the coin supports, e.g. only BIP44 for Dogecoin
+// Chains with a single address type always derive on its purpose, the selection only filters chains with several
+// Coins without a secp256k1 chain support none, their paths already fix the purpose
+func (c *Coin) Purposes(purposes []Purpose) []Purpose {
+	if c.Chain == nil {
+		return nil
+	}
+	if supported := c.supported(Purposes); len(supported) == 1 {
+		return supported
+	}
+	return c.supported(purposes)
+}
+
+// supported returns the purposes whose address type the chain supports
+func (c *Coin) supported(purposes []Purpose) []Purpose {
+	supported := make([]Purpose, 0, len(purposes))
+	for _, purpose := range purposes {
+		addressType, err := purpose.AddressType()
+		if err != nil {
+			continue
+		}
+		if c.Chain.Supports(addressType) {
+			supported = append(supported, purpose)
+		}
+	}
+	return supported
+}
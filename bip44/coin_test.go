@@ -0,0 +1,151 @@
+package bip44
+
+import (
+	"reflect"
+	"testing"
+
+	"key-gen/btc"
+)
+
+// TestCoinTypes checks the hardened index of every coin type constant and that no two coins of the registry derive on the same coin type
+// Only the test networks share 1'
+func TestCoinTypes(t *testing.T) {
+	tests := []struct {
+		coinType CoinType
+		index    uint32
+	}{
+		{CoinTypeBitcoin, 0},
+		{CoinTypeTestnet, 1},
+		{CoinTypeLitecoin, 2},
+		{CoinTypeDogecoin, 3},
+		{CoinTypeDash, 5},
+		{CoinTypeEthereum, 60},
+		{CoinTypeEthereumClassic, 61},
+		{CoinTypeBitcoinCash, 145},
+		{CoinTypeBitcoinSV, 201},
+		{CoinTypeLitecoinTestnet, 4},
+		{CoinTypeBitcoinGold, 156},
+		{CoinTypeZcash, 133},
+		{CoinTypeZcashTestnet, 1},
+		{CoinTypeRavencoin, 175},
+		{CoinTypeMonacoin, 22},
+		{CoinTypeDecred, 42},
+		{CoinTypeGroestlcoin, 17},
+		{CoinTypeDigiByte, 20},
+		{CoinTypeQtum, 2301},
+		{CoinTypeViacoin, 14},
+		{CoinTypeBitcoinPrivate, 204},
+		{CoinTypeBitcoinZ, 182},
+		{CoinTypeZelcash, 184},
+		{CoinTypeSnowGem, 183},
+		{CoinTypeBitcore, 13},
+		{CoinTypeZenCash, 32},
+		{CoinTypePeercoin, 6},
+		{CoinTypeTron, 195},
+		{CoinTypeXRP, 144},
+		{CoinTypeSolana, 501},
+		{CoinTypeStellar, 148},
+		{CoinTypeAptos, 637},
+		{CoinTypeSui, 784},
+		{CoinTypeFilecoin, 461},
+		{CoinTypeTezos, 1729},
+		{CoinTypeAvalanche, 9000},
+		{CoinTypeKaspa, 111111},
+		{CoinTypeCardano, 1815},
+		{CoinTypeBitcoinAtom, 154},
+		{CoinTypeBitcoinInterest, 206},
+		{CoinTypeBitcoinGreen, 140},
+		{CoinTypeBitcoinPlus, 102},
+	}
+	indexes := make(map[uint32]CoinType)
+	for _, test := range tests {
+		if got := uint32(test.coinType) - Apostrophe; got != test.index {
+			t.Errorf("coin type %#x = %d', want %d'", uint32(test.coinType), got, test.index)
+		}
+		if other, ok := indexes[test.index]; ok && test.index != 1 {
+			t.Errorf("coin types %#x and %#x share %d'", uint32(other), uint32(test.coinType), test.index)
+		}
+		indexes[test.index] = test.coinType
+	}
+
+	seen := make(map[CoinType]*Coin)
+	for _, coin := range Coins {
+		if other, ok := seen[coin.CoinType]; ok {
+			t.Errorf("%s and %s share coin type %d'", other.Name, coin.Name, uint32(coin.CoinType)-Apostrophe)
+		}
+		seen[coin.CoinType] = coin
+	}
+}
+
+func TestParseCoin(t *testing.T) {
+	tests := []struct {
+		symbol  string
+		coin    *Coin
+		wantErr bool
+	}{
+		{"btc", CoinBitcoin, false},
+		{"LTC", CoinLitecoin, false},
+		{"ppc", CoinPeercoin, false},
+		{"via", CoinViacoin, false},
+		{"kas", CoinKaspa, false},
+		{"eth", nil, true},
+		{"", nil, true},
+	}
+	for _, test := range tests {
+		coin, err := ParseCoin(test.symbol)
+		if (err != nil) != test.wantErr {
+			t.Errorf("ParseCoin(%q) error = %v, wantErr %v", test.symbol, err, test.wantErr)
+		}
+		if coin != test.coin {
+			t.Errorf("ParseCoin(%q) = %v, want %v", test.symbol, coin, test.coin)
+		}
+	}
+}
+
+// TestCoinPurposes checks that the purpose selection only filters chains with several address types
+func TestCoinPurposes(t *testing.T) {
+	tests := []struct {
+		name     string
+		coin     *Coin
+		purposes []Purpose
+		want     []Purpose
+	}{
+		{"bitcoin", CoinBitcoin, []Purpose{PurposeBIP84, PurposeBIP86}, []Purpose{PurposeBIP84, PurposeBIP86}},
+		{"litecoin drops taproot", CoinLitecoin, Purposes, []Purpose{PurposeBIP44, PurposeBIP49, PurposeBIP84}},
+		{"litecoin taproot only", CoinLitecoin, []Purpose{PurposeBIP86}, []Purpose{}},
+		{"tron ignores segwit", CoinTron, []Purpose{PurposeBIP84}, []Purpose{PurposeBIP44}},
+		{"dogecoin", CoinDogecoin, Purposes, []Purpose{PurposeBIP44}},
+		{"solana", CoinSolana, Purposes, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.coin.Purposes(test.purposes); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Purposes() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestExportOptionsValidatePurposes(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    ExportOptions
+		wantErr bool
+	}{
+		{"tron with segwit purposes", ExportOptions{Coins: []*Coin{CoinTron}, Purposes: []Purpose{PurposeBIP84}, Compress: true}, false},
+		{"litecoin with taproot only", ExportOptions{Coins: []*Coin{CoinLitecoin}, Purposes: []Purpose{PurposeBIP86}, Compress: true}, true},
+		{"uncompressed dogecoin", ExportOptions{Coins: []*Coin{CoinDogecoin}, Purposes: Purposes}, false},
+		{"uncompressed bitcoin segwit", ExportOptions{Coins: []*Coin{CoinBitcoin}, Purposes: Purposes}, true},
+		{"uncompressed decred", ExportOptions{Coins: []*Coin{CoinDecred}, Purposes: []Purpose{PurposeBIP44}}, true},
+		{"unknown purpose", ExportOptions{Purposes: []Purpose{Purpose(Apostrophe + 45)}, Compress: true}, true},
+		{"kaspa testnet", ExportOptions{Coins: []*Coin{CoinKaspa}, Network: btc.Testnet, Compress: true}, false},
+		{"cardano testnet", ExportOptions{Coins: []*Coin{CoinCardano}, Network: btc.Testnet, Compress: true}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.opts.Validate(); (err != nil) != test.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Package bip44
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bip44
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+
+	"key-gen/cosmos"
+)
+
+// CosmosChain is a Cosmos SDK chain, the bech32 HRP of its accounts and the coin type wallets derive it with
+type CosmosChain struct {
+	Symbol   string
+	Name     string
+	HRP      string
+	CoinType CoinType
+}
+
+const (
+	CoinTypeCosmos CoinType = 0x80000076 // 118' Cosmos Hub
+	CoinTypeTerra  CoinType = 0x8000014a // 330' Terra
+	CoinTypeKava   CoinType = 0x800001cb // 459' Kava
+	CoinTypeSecret CoinType = 0x80000211 // 529' Secret Network
+)
+
+var (
+	CosmosChainCosmosHub = &CosmosChain{Symbol: "cosmos", Name: "Cosmos Hub", HRP: "cosmos", CoinType: CoinTypeCosmos}
+	CosmosChainOsmosis   = &CosmosChain{Symbol: "osmosis", Name: "Osmosis", HRP: "osmo", CoinType: CoinTypeCosmos}
+	CosmosChainCelestia  = &CosmosChain{Symbol: "celestia", Name: "Celestia", HRP: "celestia", CoinType: CoinTypeCosmos}
+	CosmosChainDYDX      = &CosmosChain{Symbol: "dydx", Name: "dYdX", HRP: "dydx", CoinType: CoinTypeCosmos}
+	CosmosChainAkash     = &CosmosChain{Symbol: "akash", Name: "Akash", HRP: "akash", CoinType: CoinTypeCosmos}
+	CosmosChainJuno      = &CosmosChain{Symbol: "juno", Name: "Juno", HRP: "juno", CoinType: CoinTypeCosmos}
+	CosmosChainStargaze  = &CosmosChain{Symbol: "stargaze", Name: "Stargaze", HRP: "stars", CoinType: CoinTypeCosmos}
+	CosmosChainNoble     = &CosmosChain{Symbol: "noble", Name: "Noble", HRP: "noble", CoinType: CoinTypeCosmos}
+	CosmosChainSei       = &CosmosChain{Symbol: "sei", Name: "Sei", HRP: "sei", CoinType: CoinTypeCosmos}
+	CosmosChainTerra     = &CosmosChain{Symbol: "terra", Name: "Terra", HRP: "terra", CoinType: CoinTypeTerra}
+	CosmosChainKava      = &CosmosChain{Symbol: "kava", Name: "Kava", HRP: "kava", CoinType: CoinTypeKava}
+	CosmosChainSecret    = &CosmosChain{Symbol: "secret", Name: "Secret Network", HRP: "secret", CoinType: CoinTypeSecret}
+)
+
+// CosmosChains is the chain registry, chains missing from it can be given as hrp:coin_type
+var CosmosChains = []*CosmosChain{
+	CosmosChainCosmosHub,
+	CosmosChainOsmosis,
+	CosmosChainCelestia,
+	CosmosChainDYDX,
+	CosmosChainAkash,
+	CosmosChainJuno,
+	CosmosChainStargaze,
+	CosmosChainNoble,
+	CosmosChainSei,
+	CosmosChainTerra,
+	CosmosChainKava,
+	CosmosChainSecret,
+}
+
+// ParseCosmosChain returns the registered chain with the given symbol, e.g. osmosis
+// A chain that is not registered is given by its HRP and unhardened coin type, e.g. axelar:118
+func ParseCosmosChain(symbol string) (*CosmosChain, error) {
+	for _, chain := range CosmosChains {
+		if strings.EqualFold(chain.Symbol, symbol) {
+			return chain, nil
+		}
+	}
+	if hrp, coinType, ok := strings.Cut(symbol, ":"); ok && hrp != "" {
+		level, err := strconv.ParseUint(coinType, 10, 32)
+		if err != nil || level >= uint64(Apostrophe) {
+			return nil, fmt.Errorf("invalid Cosmos coin type %q in %q", coinType, symbol)
+		}
+		hrp = strings.ToLower(hrp)
+		return &CosmosChain{Symbol: hrp, Name: hrp, HRP: hrp, CoinType: CoinType(uint32(level) + Apostrophe)}, nil
+	}
+	symbols := make([]string, 0, len(CosmosChains))
+	for _, chain := range CosmosChains {
+		symbols = append(symbols, chain.Symbol)
+	}
+	return nil, fmt.Errorf("invalid Cosmos chain %q, expected one of %s or hrp:coin_type", symbol, strings.Join(symbols, ", "))
+}
+
+type CosmosAccountJSON struct {
+	KeyAccountJSON
+	PrivateKeyBase64 string `json:"private_key_base64"`
+}
+
+type CosmosAccountsJSON struct {
+	Symbol   string              `json:"chain"`
+	Name     string              `json:"name"`
+	HRP      string              `json:"hrp"`
+	Accounts []CosmosAccountJSON `json:"accounts"`
+}
+
+// cosmosAccounts returns the accounts of a Cosmos chain for the selected account, change chains and indices
+// The private key is given as hex and as base64, the two formats Cosmos SDK keyrings import
+func (km *KeyManager) cosmosAccounts(chain *CosmosChain, opts ExportOptions) (CosmosAccountsJSON, error) {
+	accounts := make([]CosmosAccountJSON, 0)
+	for _, pair := range opts.indices() {
+		key, err := km.Key(PurposeBIP44, chain.CoinType, opts.Account, pair[0], pair[1])
+		if err != nil {
+			return CosmosAccountsJSON{}, err
+		}
+		prvKey, pubKey := btcec.PrivKeyFromBytes(key.Key)
+		address, err := cosmos.EncodeAddress(chain.HRP, pubKey)
+		if err != nil {
+			return CosmosAccountsJSON{}, err
+		}
+		accounts = append(accounts, CosmosAccountJSON{
+			KeyAccountJSON: KeyAccountJSON{
+				Path:       key.Path,
+				Address:    address,
+				PrivateKey: cosmos.HexPrivateKey(prvKey),
+				KeyType:    fmt.Sprintf("%s(bech32)", chain.Name),
+			},
+			PrivateKeyBase64: cosmos.Base64PrivateKey(prvKey),
+		})
+	}
+	return CosmosAccountsJSON{
+		Symbol:   chain.Symbol,
+		Name:     chain.Name,
+		HRP:      chain.HRP,
+		Accounts: accounts,
+	}, nil
+}
+
+// prettyCosmosAccounts renders the accounts of a Cosmos chain with a hex and a base64 private key column
+func prettyCosmosAccounts(chain CosmosAccountsJSON) string {
+	pathLabel, addressLabel, hexLabel, base64Label := "Path(BIP44)", fmt.Sprintf("%s(bech32)", chain.Name), "Private Key(hex)", "Private Key(base64)"
+	pathWidth, addressWidth, hexWidth := max(len(pathLabel), 18), len(addressLabel), len(hexLabel)
+	for _, account := range chain.Accounts {
+		pathWidth = max(pathWidth, len(account.Path))
+		addressWidth = max(addressWidth, len(account.Address))
+		hexWidth = max(hexWidth, len(account.PrivateKey))
+	}
+
+	sp := fmt.Sprintf("\n%-*s %-*s %-*s %s\n", pathWidth, pathLabel, addressWidth, addressLabel, hexWidth, hexLabel, base64Label)
+	sp += strings.Repeat("-", pathWidth+addressWidth+hexWidth+47)
+	sp += "\n"
+	for _, account := range chain.Accounts {
+		sp += fmt.Sprintf("%-*s %-*s %-*s %s\n", pathWidth, account.Path, addressWidth, account.Address, hexWidth, account.PrivateKey, account.PrivateKeyBase64)
+	}
+	return sp
+}
@@ -0,0 +1,33 @@
+package bip44
+
+import (
+	"testing"
+)
+
+func TestParseCosmosChain(t *testing.T) {
+	tests := []struct {
+		symbol   string
+		hrp      string
+		coinType CoinType
+		wantErr  bool
+	}{
+		{"cosmos", "cosmos", CoinTypeCosmos, false},
+		{"Stargaze", "stars", CoinTypeCosmos, false},
+		{"terra", "terra", CoinTypeTerra, false},
+		{"Axelar:118", "axelar", CoinTypeCosmos, false},
+		{"axelar", "", 0, true},
+		{"axelar:", "", 0, true},
+		{":118", "", 0, true},
+		{"axelar:2147483648", "", 0, true},
+	}
+	for _, test := range tests {
+		chain, err := ParseCosmosChain(test.symbol)
+		if (err != nil) != test.wantErr {
+			t.Errorf("ParseCosmosChain(%q) error = %v, wantErr %v", test.symbol, err, test.wantErr)
+			continue
+		}
+		if err == nil && (chain.HRP != test.hrp || chain.CoinType != test.coinType) {
+			t.Errorf("ParseCosmosChain(%q) = %s %d', want %s %d'", test.symbol, chain.HRP, uint32(chain.CoinType)-Apostrophe, test.hrp, uint32(test.coinType)-Apostrophe)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Package bip44
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bip44
+
+import (
+	"fmt"
+
+	"key-gen/btc"
+)
+
+// BIP380-386 : Output Script Descriptors
+// https://github.com/bitcoin/bips/blob/master/bip-0380.mediawiki
+type DescriptorJSON struct {
+	KeyType    string `json:"type"`
+	Chain      string `json:"chain"`
+	Descriptor string `json:"descriptor"`
+	Private    string `json:"private_descriptor"`
+}
+
+// descriptors returns the receive and change descriptors of every purpose for the selected account
+// Descriptors always use the xpub and xprv versions, or tpub and tprv on test networks, the script expression already defines the address type
+func (km *KeyManager) descriptors(masterFingerprint []byte, opts ExportOptions) ([]DescriptorJSON, error) {
+	descriptors := make([]DescriptorJSON, 0)
+	for _, purpose := range opts.Purposes {
+		addressType, err := purpose.AddressType()
+		if err != nil {
+			return nil, err
+		}
+		script := addressType.DescriptorScript()
+		key, err := km.AccountKey(purpose, NetworkCoinType(opts.network()), opts.Account)
+		if err != nil {
+			return nil, err
+		}
+		origin := KeyOrigin(masterFingerprint, key.Path)
+		for _, change := range []Index{ChangeExternal, ChangeInternal} {
+			chain := "receive"
+			if change == ChangeInternal {
+				chain = "change"
+			}
+			public, err := btc.Descriptor(script, fmt.Sprintf("%s%s/%s/*", origin, key.ExtendedPublicKey(opts.network().PublicVersion), change))
+			if err != nil {
+				return nil, err
+			}
+			private, err := btc.Descriptor(script, fmt.Sprintf("%s%s/%s/*", origin, key.ExtendedPrivateKey(opts.network().PrivateVersion()), change))
+			if err != nil {
+				return nil, err
+			}
+			descriptors = append(descriptors, DescriptorJSON{
+				KeyType:    fmt.Sprintf("BIP%d", uint32(purpose)-Apostrophe),
+				Chain:      chain,
+				Descriptor: public,
+				Private:    private,
+			})
+		}
+	}
+	return descriptors, nil
+}
@@ -0,0 +1,88 @@
+package bip44
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestDescriptors checks the receive and change descriptors of the abandon about mnemonic
+// The account keys are those of the BIP84 and BIP86 test vectors, shown with xpub versions as descriptors require
+func TestDescriptors(t *testing.T) {
+	km, err := NewKeyManager("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mainKey, err := km.MainKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		purpose Purpose
+		receive string
+		change  string
+	}{
+		{
+			PurposeBIP84,
+			"wpkh([73c5da0a/84'/0'/0']xpub6CatWdiZiodmUeTDp8LT5or8nmbKNcuyvz7WyksVFkKB4RHwCD3XyuvPEbvqAQY3rAPshWcMLoP2fMFMKHPJ4ZeZXYVUhLv1VMrjPC7PW6V/0/*)#wc3n3van",
+			"wpkh([73c5da0a/84'/0'/0']xpub6CatWdiZiodmUeTDp8LT5or8nmbKNcuyvz7WyksVFkKB4RHwCD3XyuvPEbvqAQY3rAPshWcMLoP2fMFMKHPJ4ZeZXYVUhLv1VMrjPC7PW6V/1/*)#lv5jvedt",
+		},
+		{
+			PurposeBIP86,
+			"tr([73c5da0a/86'/0'/0']xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ/0/*)#rg247h69",
+			"tr([73c5da0a/86'/0'/0']xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ/1/*)#ju05rz2a",
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("BIP%d", uint32(test.purpose)-Apostrophe), func(t *testing.T) {
+			descriptors, err := km.descriptors(mainKey.Fingerprint(), ExportOptions{Purposes: []Purpose{test.purpose}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(descriptors) != 2 {
+				t.Fatalf("got %d descriptors, want receive and change", len(descriptors))
+			}
+			if descriptors[0].Chain != "receive" || descriptors[0].Descriptor != test.receive {
+				t.Errorf("receive descriptor = %s, want %s", descriptors[0].Descriptor, test.receive)
+			}
+			if descriptors[1].Chain != "change" || descriptors[1].Descriptor != test.change {
+				t.Errorf("change descriptor = %s, want %s", descriptors[1].Descriptor, test.change)
+			}
+		})
+	}
+}
+
+// TestExportDescriptors checks that descriptors are only exported with compressed keys
+// BIP380 key expressions derived from an extended key are always compressed
+func TestExportDescriptors(t *testing.T) {
+	km, err := NewKeyManager("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		compress    bool
+		descriptors int
+	}{
+		{true, 2},
+		{false, 0},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("compress %v", test.compress), func(t *testing.T) {
+			kmj, err := km.Export(ExportOptions{
+				Accounts: 1,
+				Changes:  []Index{ChangeExternal},
+				Purposes: []Purpose{PurposeBIP44},
+				Compress: test.compress,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(kmj.Descriptors) != test.descriptors {
+				t.Errorf("got %d descriptors, want %d", len(kmj.Descriptors), test.descriptors)
+			}
+			if len(kmj.BitcoinAccounts) == 0 {
+				t.Error("got no Bitcoin accounts")
+			}
+		})
+	}
+}
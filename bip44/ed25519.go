@@ -0,0 +1,121 @@
+// Package bip44
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bip44
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	"key-gen/slip10"
+)
+
+// Ed25519Chain encodes the addresses and private keys of an ed25519 coin derived with SLIP-0010
+type Ed25519Chain interface {
+	Label() string // name of the address encoding
+	Address(pubKey ed25519.PublicKey) (string, error)
+	PrivateKey(prvKey ed25519.PrivateKey) (string, error) // in the format the chain's wallets import
+	PrivateKeyFormat() string                             // label of the private key encoding
+}
+
+// Ed25519Key is a SLIP-0010 ed25519 key and the path it was derived at
+type Ed25519Key struct {
+	Path      string
+	SLIP10Key *slip10.Key
+}
+
+// PublicKey returns the ed25519 public key
+func (k *Ed25519Key) PublicKey() ed25519.PublicKey {
+	return k.SLIP10Key.PublicKey()
+}
+
+// PrivateKey returns the 64-byte ed25519 private key
+func (k *Ed25519Key) PrivateKey() ed25519.PrivateKey {
+	return k.SLIP10Key.PrivateKey()
+}
+
+// HexKey returns the 32-byte private key as a hex string
+func (k *Ed25519Key) HexKey() string {
+	return fmt.Sprintf("%x", k.SLIP10Key.Key)
+}
+
+// getEd25519Key returns the ed25519 key for the given path
+func (km *KeyManager) getEd25519Key(path string) (*slip10.Key, bool) {
+	km.mux.Lock()
+	defer km.mux.Unlock()
+
+	key, ok := km.ed25519Keys[path]
+	return key, ok
+}
+
+// setEd25519Key sets the ed25519 key for the given path
+func (km *KeyManager) setEd25519Key(path string, key *slip10.Key) {
+	km.mux.Lock()
+	defer km.mux.Unlock()
+
+	km.ed25519Keys[path] = key
+}
+
+// Ed25519MainKey returns the SLIP-0010 ed25519 master key of the seed
+func (km *KeyManager) Ed25519MainKey() *Ed25519Key {
+	path := "m"
+	key, ok := km.getEd25519Key(path)
+	if !ok {
+		key = slip10.NewMasterKey(km.Seed())
+		km.setEd25519Key(path, key)
+	}
+	return &Ed25519Key{path, key}
+}
+
+// Ed25519KeyByPath returns the SLIP-0010 ed25519 key for a path such as m/44'/501'/0'/0'
+// ed25519 only supports hardened derivation, so every level of the path must be hardened
+func (km *KeyManager) Ed25519KeyByPath(path string) (*Ed25519Key, error) {
+	indices, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key := km.Ed25519MainKey()
+	for i, index := range indices {
+		childPath := FormatPath(indices[:i+1])
+		child, ok := km.getEd25519Key(childPath)
+		if !ok {
+			child, err = key.SLIP10Key.NewChildKey(index)
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %q: %w", path, err)
+			}
+			km.setEd25519Key(childPath, child)
+		}
+		key = &Ed25519Key{childPath, child}
+	}
+
+	return key, nil
+}
+
+// ed25519Accounts returns the accounts of an ed25519 coin, one per index from the start index
+// These wallets put the index on a hardened level of the coin's path template, so the account and change levels are not used
+func (km *KeyManager) ed25519Accounts(coin *Coin, opts ExportOptions) ([]KeyAccountJSON, error) {
+	accounts := make([]KeyAccountJSON, 0)
+	for i := 0; i < opts.Accounts; i++ {
+		key, err := km.Ed25519KeyByPath(fmt.Sprintf(coin.Ed25519Path, uint32(opts.StartIndex)+uint32(i)))
+		if err != nil {
+			return nil, err
+		}
+		address, err := coin.Ed25519.Address(key.PublicKey())
+		if err != nil {
+			return nil, err
+		}
+		privateKey, err := coin.Ed25519.PrivateKey(key.PrivateKey())
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, KeyAccountJSON{
+			Path:       key.Path,
+			Address:    address,
+			PrivateKey: privateKey,
+			KeyType:    coin.Ed25519.Label(),
+		})
+	}
+	return accounts, nil
+}
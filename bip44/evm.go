@@ -0,0 +1,103 @@
+// Package bip44
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bip44
+
+import (
+	"fmt"
+	"strings"
+
+	"key-gen/evm"
+)
+
+// EVMChain is an EVM chain preset, its chain id and the coin type wallets derive it with
+// Most chains reuse Ethereum's 60', EIP1191 marks chains whose addresses use the chain-aware checksum
+type EVMChain struct {
+	Symbol   string
+	Name     string
+	ChainID  uint64
+	CoinType CoinType
+	EIP1191  bool
+}
+
+const (
+	CoinTypeRSK CoinType = 0x80000089 // 137' RSK
+)
+
+var (
+	EVMChainEthereum        = &EVMChain{Symbol: "eth", Name: "Ethereum", ChainID: 1, CoinType: CoinTypeEthereum}
+	EVMChainEthereumClassic = &EVMChain{Symbol: "etc", Name: "Ethereum Classic", ChainID: 61, CoinType: CoinTypeEthereumClassic}
+	EVMChainRSK             = &EVMChain{Symbol: "rsk", Name: "RSK", ChainID: 30, CoinType: CoinTypeRSK, EIP1191: true}
+	EVMChainPolygon         = &EVMChain{Symbol: "polygon", Name: "Polygon", ChainID: 137, CoinType: CoinTypeEthereum}
+	EVMChainBSC             = &EVMChain{Symbol: "bsc", Name: "BNB Smart Chain", ChainID: 56, CoinType: CoinTypeEthereum}
+	EVMChainArbitrum        = &EVMChain{Symbol: "arbitrum", Name: "Arbitrum One", ChainID: 42161, CoinType: CoinTypeEthereum}
+	EVMChainOptimism        = &EVMChain{Symbol: "optimism", Name: "OP Mainnet", ChainID: 10, CoinType: CoinTypeEthereum}
+	EVMChainBase            = &EVMChain{Symbol: "base", Name: "Base", ChainID: 8453, CoinType: CoinTypeEthereum}
+	EVMChainAvalanche       = &EVMChain{Symbol: "avalanche", Name: "Avalanche C-Chain", ChainID: 43114, CoinType: CoinTypeEthereum}
+	EVMChainGnosis          = &EVMChain{Symbol: "gnosis", Name: "Gnosis", ChainID: 100, CoinType: CoinTypeEthereum}
+	EVMChainFantom          = &EVMChain{Symbol: "fantom", Name: "Fantom", ChainID: 250, CoinType: CoinTypeEthereum}
+)
+
+// EVMChains lists every EVM chain preset that can be selected by its symbol
+var EVMChains = []*EVMChain{
+	EVMChainEthereum,
+	EVMChainEthereumClassic,
+	EVMChainRSK,
+	EVMChainPolygon,
+	EVMChainBSC,
+	EVMChainArbitrum,
+	EVMChainOptimism,
+	EVMChainBase,
+	EVMChainAvalanche,
+	EVMChainGnosis,
+	EVMChainFantom,
+}
+
+// ParseEVMChain returns the EVM chain preset with the given symbol, e.g. etc
+func ParseEVMChain(symbol string) (*EVMChain, error) {
+	for _, chain := range EVMChains {
+		if strings.EqualFold(chain.Symbol, symbol) {
+			return chain, nil
+		}
+	}
+	symbols := make([]string, 0, len(EVMChains))
+	for _, chain := range EVMChains {
+		symbols = append(symbols, chain.Symbol)
+	}
+	return nil, fmt.Errorf("invalid EVM chain %q, expected one of %s", symbol, strings.Join(symbols, ", "))
+}
+
+// Label returns the chain name and the checksum its addresses use, e.g. RSK(EIP1191, chain 30)
+func (c *EVMChain) Label() string {
+	if c.EIP1191 {
+		return fmt.Sprintf("%s(EIP1191, chain %d)", c.Name, c.ChainID)
+	}
+	return fmt.Sprintf("%s(EIP55)", c.Name)
+}
+
+// Address returns the checksummed address of the key on the chain
+func (c *EVMChain) Address(key *Key) string {
+	if c.EIP1191 {
+		return evm.ChainChecksumAddress(key.EVMAddress, c.ChainID)
+	}
+	return evm.ChecksumAddress(key.EVMAddress)
+}
+
+// evmAccounts returns the accounts of an EVM chain for the selected account, change chains and indices
+func (km *KeyManager) evmAccounts(chain *EVMChain, opts ExportOptions) ([]KeyAccountJSON, error) {
+	accounts := make([]KeyAccountJSON, 0)
+	for _, pair := range opts.indices() {
+		key, err := km.Key(PurposeBIP44, chain.CoinType, opts.Account, pair[0], pair[1])
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, KeyAccountJSON{
+			Path:       key.Path,
+			Address:    chain.Address(key),
+			PrivateKey: key.HexKey(),
+			KeyType:    chain.Label(),
+		})
+	}
+	return accounts, nil
+}
@@ -0,0 +1,62 @@
+package bip44
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseEVMChain(t *testing.T) {
+	tests := []struct {
+		symbol  string
+		chain   *EVMChain
+		wantErr bool
+	}{
+		{"eth", EVMChainEthereum, false},
+		{"RSK", EVMChainRSK, false},
+		{"etc", EVMChainEthereumClassic, false},
+		{"btc", nil, true},
+	}
+	for _, test := range tests {
+		chain, err := ParseEVMChain(test.symbol)
+		if (err != nil) != test.wantErr {
+			t.Errorf("ParseEVMChain(%q) error = %v, wantErr %v", test.symbol, err, test.wantErr)
+		}
+		if chain != test.chain {
+			t.Errorf("ParseEVMChain(%q) = %v, want %v", test.symbol, chain, test.chain)
+		}
+	}
+}
+
+// TestEVMChainAddress checks that chains on the Ethereum coin type share its addresses and RSK derives its own on
+// coin type 137' with the EIP-1191 checksum
+func TestEVMChainAddress(t *testing.T) {
+	km, err := NewKeyManager("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kmj, err := km.Export(ExportOptions{
+		Accounts:  1,
+		Changes:   []Index{ChangeExternal},
+		EVMChains: []*EVMChain{EVMChainPolygon, EVMChainRSK},
+		Compress:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kmj.EVMAccounts) != 2 {
+		t.Fatalf("got %d EVM accounts, want 2", len(kmj.EVMAccounts))
+	}
+	polygon, rsk := kmj.EVMAccounts[0], kmj.EVMAccounts[1]
+	if want := "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"; polygon.Address != want {
+		t.Errorf("Polygon address = %s, want %s", polygon.Address, want)
+	}
+	if want := "m/44'/137'/0'/0/0"; rsk.Path != want {
+		t.Errorf("RSK path = %s, want %s", rsk.Path, want)
+	}
+	if want := "RSK(EIP1191, chain 30)"; rsk.KeyType != want {
+		t.Errorf("RSK type = %s, want %s", rsk.KeyType, want)
+	}
+	if strings.EqualFold(rsk.Address, polygon.Address) {
+		t.Errorf("RSK address %s is the Ethereum coin type address", rsk.Address)
+	}
+}
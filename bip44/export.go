@@ -0,0 +1,524 @@
+// Package bip44
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bip44
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+
+	"key-gen/btc"
+)
+
+// https://github.com/bitcoin/bips/blob/master/bip-0044.mediawiki#change
+const (
+	ChangeExternal Index = 0 // 0 external chain, used for receiving addresses
+	ChangeInternal Index = 1 // 1 internal chain, used for change addresses
+)
+
+// ExportOptions selects the part of the BIP44 tree rendered by ToJSON, ToPrettyString and the savers
+type ExportOptions struct {
+	Accounts   int            // number of address indices generated per chain
+	Account    HardenedIndex  // account level of the path
+	Changes    []Index        // change levels of the path, external and/or internal
+	StartIndex Index          // first address index generated per chain
+	Purposes   []Purpose      // purposes, each generating the address type it defines
+	Coins      []*Coin        // secp256k1 coins, Bitcoin when empty
+	EVMChains  []*EVMChain    // EVM chain presets, Ethereum when empty
+	Cosmos     []*CosmosChain // Cosmos SDK chains, none when empty
+	Network    *btc.Network   // Bitcoin network, mainnet when nil
+	XRPTag     *uint32        // destination tag packed into XRP X-addresses, none when nil
+	Compress   bool
+}
+
+// Exporter is implemented by KeyManager and WatchOnlyManager so both can be rendered and saved
+type Exporter interface {
+	Export(opts ExportOptions) (*KeyManagerJSON, error)
+}
+
+type KeyAccountJSON struct {
+	Path       string `json:"path"`
+	Address    string `json:"address"`
+	PrivateKey string `json:"private_key"`
+	KeyType    string `json:"type"`
+}
+
+type CoinAccountsJSON struct {
+	Symbol           string           `json:"coin"`
+	Name             string           `json:"name"`
+	PrivateKeyFormat string           `json:"private_key_format"`
+	Accounts         []KeyAccountJSON `json:"accounts"`
+}
+
+type KeyManagerJSON struct {
+	Network           string               `json:"network"`
+	Mnemonic          string               `json:"recovery_phrase"`
+	Passphrase        string               `json:"mnemonic_password"`
+	Seed              string               `json:"seed"`
+	RootKey           string               `json:"root_key"`
+	MasterFingerprint string               `json:"master_fingerprint"`
+	ExtendedKeys      []ExtendedKeyJSON    `json:"extended_keys"`
+	Descriptors       []DescriptorJSON     `json:"descriptors"`
+	EVMAccounts       []KeyAccountJSON     `json:"evm_accounts"`
+	BitcoinAccounts   []KeyAccountJSON     `json:"bitcoin_accounts"`
+	XRPAccounts       []KeyAccountJSON     `json:"xrp_accounts"`
+	CosmosAccounts    []CosmosAccountsJSON `json:"cosmos_accounts"`
+	CoinAccounts      []CoinAccountsJSON   `json:"coin_accounts"`
+}
+
+// Validate checks that every level selected by the options is a valid BIP32 index
+func (opts ExportOptions) Validate() error {
+	if opts.Accounts < 0 {
+		return fmt.Errorf("the number of accounts must not be negative")
+	}
+	if err := opts.Account.Validate(); err != nil {
+		return err
+	}
+	for _, change := range opts.Changes {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+	}
+	if uint64(opts.StartIndex)+uint64(opts.Accounts) > uint64(Apostrophe) {
+		return fmt.Errorf("address %w: %d + %d accounts", ErrIndexOutOfRange, uint32(opts.StartIndex), opts.Accounts)
+	}
+	for _, purpose := range opts.Purposes {
+		if _, err := purpose.AddressType(); err != nil {
+			return err
+		}
+	}
+	for _, coin := range opts.coins() {
+		if coin != CoinBitcoin && opts.network() != btc.Mainnet && (coin.Testnet == nil || opts.network() != btc.Testnet) {
+			return fmt.Errorf("%s has no %s, signet and regtest are only supported for Bitcoin", coin.Name, opts.network().Name)
+		}
+		if coin.Chain != nil && len(opts.Purposes) > 0 && len(coin.Purposes(opts.Purposes)) == 0 {
+			return fmt.Errorf("%s supports none of the selected purposes", coin.Name)
+		}
+		for _, purpose := range coin.Purposes(opts.Purposes) {
+			addressType, _ := purpose.AddressType()
+			if err := addressType.Validate(opts.Compress); err != nil {
+				return fmt.Errorf("BIP%d: %w", uint32(purpose)-Apostrophe, err)
+			}
+		}
+		if validator, ok := coin.Chain.(Validator); ok {
+			if err := validator.Validate(opts.Compress); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// network returns the Bitcoin network selected by the options
+func (opts ExportOptions) network() *btc.Network {
+	if opts.Network == nil {
+		return btc.Mainnet
+	}
+	return opts.Network
+}
+
+// coins returns the selected coins, Bitcoin when none are selected
+func (opts ExportOptions) coins() []*Coin {
+	if len(opts.Coins) == 0 {
+		return []*Coin{CoinBitcoin}
+	}
+	return opts.Coins
+}
+
+// evmChains returns the selected EVM chains, Ethereum when none are selected
+func (opts ExportOptions) evmChains() []*EVMChain {
+	if len(opts.EVMChains) == 0 {
+		return []*EVMChain{EVMChainEthereum}
+	}
+	return opts.EVMChains
+}
+
+// hasCoin reports whether the coin is selected
+func (opts ExportOptions) hasCoin(coin *Coin) bool {
+	for _, c := range opts.coins() {
+		if c == coin {
+			return true
+		}
+	}
+	return false
+}
+
+// HardenedAccountChanged reports whether the options select keys that earlier versions of key-gen derived
+// without hardening the account level, the external chain of account 0 of Bitcoin mainnet and Ethereum
+func (opts ExportOptions) HardenedAccountChanged() bool {
+	if opts.Account != 0 {
+		return false
+	}
+	external := false
+	for _, change := range opts.Changes {
+		external = external || change == ChangeExternal
+	}
+	if !external {
+		return false
+	}
+	if opts.hasCoin(CoinBitcoin) && opts.network() == btc.Mainnet {
+		return true
+	}
+	for _, chain := range opts.evmChains() {
+		if chain.CoinType == CoinTypeEthereum {
+			return true
+		}
+	}
+	return false
+}
+
+// chain returns the chain and coin type a coin is derived with on the selected network
+func (opts ExportOptions) chain(coin *Coin) (Chain, CoinType) {
+	switch {
+	case coin == CoinBitcoin:
+		return opts.network(), NetworkCoinType(opts.network())
+	case opts.network() != btc.Mainnet:
+		return coin.Testnet, coin.TestnetCoinType
+	}
+	return coin.Chain, coin.CoinType
+}
+
+// indices returns the change and address index pairs selected by the options
+func (opts ExportOptions) indices() [][2]Index {
+	pairs := make([][2]Index, 0, len(opts.Changes)*opts.Accounts)
+	for _, change := range opts.Changes {
+		for i := 0; i < opts.Accounts; i++ {
+			pairs = append(pairs, [2]Index{change, opts.StartIndex + Index(i)})
+		}
+	}
+	return pairs
+}
+
+// coinAccounts returns the accounts of a coin and purpose for the selected account, change chains and indices
+func (km *KeyManager) coinAccounts(coin *Coin, purpose Purpose, opts ExportOptions) ([]KeyAccountJSON, error) {
+	addressType, err := purpose.AddressType()
+	if err != nil {
+		return nil, err
+	}
+	chain, coinType := opts.chain(coin)
+	accounts := make([]KeyAccountJSON, 0)
+	for _, pair := range opts.indices() {
+		key, err := km.Key(purpose, coinType, opts.Account, pair[0], pair[1])
+		if err != nil {
+			return nil, err
+		}
+		prvKey, pubKey := btcec.PrivKeyFromBytes(key.Key)
+		address, err := chain.Address(pubKey, opts.Compress, addressType)
+		if err != nil {
+			return nil, err
+		}
+		privateKey, err := chain.PrivateKey(prvKey, opts.Compress)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, KeyAccountJSON{
+			Path:       key.Path,
+			Address:    address,
+			PrivateKey: privateKey,
+			KeyType:    chain.Label(addressType, opts.Compress),
+		})
+	}
+	return accounts, nil
+}
+
+// Export derives every account selected by the options
+func (km *KeyManager) Export(opts ExportOptions) (*KeyManagerJSON, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	mainKey, err := km.MainKey()
+	if err != nil {
+		return nil, err
+	}
+
+	masterFingerprint := mainKey.Fingerprint()
+	extendedKeys, err := km.extendedKeys(masterFingerprint, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// descriptor keys derive compressed public keys, so uncompressed exports have none
+	descriptors := make([]DescriptorJSON, 0)
+	if opts.hasCoin(CoinBitcoin) && opts.Compress {
+		descriptors, err = km.descriptors(masterFingerprint, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	btcAccounts := make([]KeyAccountJSON, 0)
+	xrpAccounts := make([]KeyAccountJSON, 0)
+	coinAccounts := make([]CoinAccountsJSON, 0)
+	for _, coin := range opts.coins() {
+		coinKeys := make([]KeyAccountJSON, 0)
+		switch {
+		case coin.Deriver != nil:
+			coinKeys, err = coin.Deriver.Accounts(km, opts)
+		case coin.Ed25519 != nil:
+			coinKeys, err = km.ed25519Accounts(coin, opts)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if coin == CoinBitcoin {
+			mainWIF, err := mainKey.NewWIF(opts.network(), opts.Compress, btc.AddressTypeP2PKH)
+			if err != nil {
+				return nil, err
+			}
+			coinKeys = append(coinKeys, KeyAccountJSON{
+				Path:       mainKey.Path,
+				Address:    mainWIF.Address,
+				PrivateKey: mainWIF.WIFString,
+				KeyType:    btc.AddressTypeP2PKH.Label(opts.Compress),
+			})
+		}
+		for _, purpose := range coin.Purposes(opts.Purposes) {
+			accounts, err := km.coinAccounts(coin, purpose, opts)
+			if err != nil {
+				return nil, err
+			}
+			coinKeys = append(coinKeys, accounts...)
+		}
+		if coin.Chain != nil {
+			secondary, err := km.secondaryAccounts(coin, opts)
+			if err != nil {
+				return nil, err
+			}
+			tagged, err := km.taggedAccounts(coin, opts)
+			if err != nil {
+				return nil, err
+			}
+			coinKeys = append(append(coinKeys, secondary...), tagged...)
+		}
+		// Bitcoin and XRP have their own sections next to the EVM accounts
+		switch coin {
+		case CoinBitcoin:
+			btcAccounts = coinKeys
+		case CoinXRP:
+			xrpAccounts = coinKeys
+		default:
+			coinAccounts = append(coinAccounts, CoinAccountsJSON{
+				Symbol:           coin.Symbol,
+				Name:             coin.Name,
+				PrivateKeyFormat: coin.PrivateKeyFormat(),
+				Accounts:         coinKeys,
+			})
+		}
+	}
+
+	evmAccounts := make([]KeyAccountJSON, 0)
+	for _, chain := range opts.evmChains() {
+		if chain == EVMChainEthereum {
+			evmAccounts = append(evmAccounts, KeyAccountJSON{
+				Path:       mainKey.Path,
+				Address:    chain.Address(mainKey),
+				PrivateKey: mainKey.HexKey(),
+				KeyType:    chain.Label(),
+			})
+		}
+		accounts, err := km.evmAccounts(chain, opts)
+		if err != nil {
+			return nil, err
+		}
+		evmAccounts = append(evmAccounts, accounts...)
+	}
+
+	cosmosAccounts := make([]CosmosAccountsJSON, 0, len(opts.Cosmos))
+	for _, chain := range opts.Cosmos {
+		accounts, err := km.cosmosAccounts(chain, opts)
+		if err != nil {
+			return nil, err
+		}
+		cosmosAccounts = append(cosmosAccounts, accounts)
+	}
+
+	return &KeyManagerJSON{
+		Network:           opts.network().Name,
+		Mnemonic:          km.Mnemonic,
+		Passphrase:        km.Passphrase,
+		Seed:              fmt.Sprintf("%x", km.Seed()),
+		RootKey:           mainKey.ExtendedPrivateKey(opts.network().PrivateVersion()),
+		MasterFingerprint: fmt.Sprintf("%x", masterFingerprint),
+		ExtendedKeys:      extendedKeys,
+		Descriptors:       descriptors,
+		BitcoinAccounts:   btcAccounts,
+		XRPAccounts:       xrpAccounts,
+		CosmosAccounts:    cosmosAccounts,
+		CoinAccounts:      coinAccounts,
+		EVMAccounts:       evmAccounts,
+	}, nil
+}
+
+// ToJSON returns the key manager as a JSON string
+func (km *KeyManager) ToJSON(opts ExportOptions) (string, error) {
+	kmj, err := km.Export(opts)
+	if err != nil {
+		return "", err
+	}
+	return kmj.ToJSON()
+}
+
+// ToPrettyString returns the key manager as human-readable tables
+func (km *KeyManager) ToPrettyString(opts ExportOptions) (string, error) {
+	kmj, err := km.Export(opts)
+	if err != nil {
+		return "", err
+	}
+	return kmj.ToPrettyString(), nil
+}
+
+// ToJSON returns the exported accounts as a JSON string
+func (kmj *KeyManagerJSON) ToJSON() (string, error) {
+	b, err := json.Marshal(kmj)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ToPrettyString returns the exported accounts as human-readable tables
+// Watch-only exports have no mnemonic or private keys, so those lines and columns are left out
+func (kmj *KeyManagerJSON) ToPrettyString() string {
+	sp := strings.Repeat("-", 200)
+	sp += "\n"
+	sp += fmt.Sprintf("%-18s %s\n", "Network:", kmj.Network)
+	if kmj.Mnemonic != "" {
+		passphrase := kmj.Passphrase
+		if passphrase == "" {
+			passphrase = "<none>"
+		}
+		sp += fmt.Sprintf("%-18s %s\n", "BIP39 Mnemonic:", kmj.Mnemonic)
+		sp += fmt.Sprintf("%-18s %s\n", "BIP39 Passphrase:", passphrase)
+		sp += fmt.Sprintf("%-18s %s\n", "BIP39 Seed:", kmj.Seed)
+		sp += fmt.Sprintf("%-18s %s\n", "BIP32 Root BIP32Key:", kmj.RootKey)
+		sp += fmt.Sprintf("%-18s %s\n", "Master Fingerprint:", kmj.MasterFingerprint)
+	}
+
+	sp += prettyExtendedKeys(kmj.ExtendedKeys)
+	sp += prettyDescriptors(kmj.Descriptors)
+
+	for _, group := range groupByKeyType(kmj.BitcoinAccounts) {
+		sp += prettyTable(fmt.Sprintf("Path(BIP%s)", purposeLabel(group)), group[0].KeyType, "WIF(Wallet Import Format)", group)
+	}
+	for _, group := range groupByKeyType(kmj.XRPAccounts) {
+		sp += prettyTable("Path(BIP44)", "XRP "+group[0].KeyType, "Private Key(hex)", group)
+	}
+	for _, coin := range kmj.CoinAccounts {
+		for _, group := range groupByKeyType(coin.Accounts) {
+			sp += prettyTable(fmt.Sprintf("Path(BIP%s)", purposeLabel(group)), fmt.Sprintf("%s %s", coin.Name, group[0].KeyType), coin.PrivateKeyFormat, group)
+		}
+	}
+	for _, group := range groupByKeyType(kmj.EVMAccounts) {
+		sp += prettyTable("Path(BIP44)", group[0].KeyType, "Private BIP32Key(hex)", group)
+	}
+	for _, chain := range kmj.CosmosAccounts {
+		sp += prettyCosmosAccounts(chain)
+	}
+	sp += "\n"
+	return sp
+}
+
+// prettyExtendedKeys renders the account extended public keys as a table with a key origin and key column
+func prettyExtendedKeys(keys []ExtendedKeyJSON) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	originWidth, typeWidth := len("Key Origin"), len("Type")
+	for _, key := range keys {
+		originWidth = max(originWidth, len(keyOrigin(key)))
+		typeWidth = max(typeWidth, len(key.KeyType))
+	}
+
+	sp := fmt.Sprintf("\n%-*s %-*s %s\n", originWidth, "Key Origin", typeWidth, "Type", "Account Extended Public Key")
+	sp += strings.Repeat("-", originWidth+typeWidth+113)
+	sp += "\n"
+	for _, key := range keys {
+		sp += fmt.Sprintf("%-*s %-*s %s\n", originWidth, keyOrigin(key), typeWidth, key.KeyType, key.PublicKey)
+	}
+	return sp
+}
+
+// keyOrigin returns the key origin of the key, or its path when it has none
+func keyOrigin(key ExtendedKeyJSON) string {
+	if key.KeyOrigin == "" {
+		return key.Path
+	}
+	return key.KeyOrigin
+}
+
+// prettyDescriptors renders the public and private descriptors of every purpose and chain
+func prettyDescriptors(descriptors []DescriptorJSON) string {
+	if len(descriptors) == 0 {
+		return ""
+	}
+	width := len("Descriptor")
+	for _, descriptor := range descriptors {
+		width = max(width, len(descriptor.Descriptor), len(descriptor.Private))
+	}
+
+	sp := fmt.Sprintf("\n%-8s %-8s %s\n", "Type", "Chain", "Descriptor")
+	sp += strings.Repeat("-", width+18)
+	sp += "\n"
+	for _, descriptor := range descriptors {
+		sp += fmt.Sprintf("%-8s %-8s %s\n", descriptor.KeyType, descriptor.Chain, descriptor.Descriptor)
+		sp += fmt.Sprintf("%-8s %-8s %s\n", "", "", descriptor.Private)
+	}
+	return sp
+}
+
+// groupByKeyType splits the accounts into consecutive groups sharing the same key type
+func groupByKeyType(accounts []KeyAccountJSON) [][]KeyAccountJSON {
+	groups := make([][]KeyAccountJSON, 0)
+	for _, account := range accounts {
+		last := len(groups) - 1
+		if last >= 0 && groups[last][0].KeyType == account.KeyType {
+			groups[last] = append(groups[last], account)
+			continue
+		}
+		groups = append(groups, []KeyAccountJSON{account})
+	}
+	return groups
+}
+
+// purposeLabel returns the purpose level of the first non-master path in the group
+func purposeLabel(accounts []KeyAccountJSON) string {
+	for _, account := range accounts {
+		levels := strings.Split(account.Path, "/")
+		if len(levels) > 1 {
+			return strings.TrimSuffix(levels[1], "'")
+		}
+	}
+	return "44"
+}
+
+// prettyTable renders the accounts as a table with a path, address and private key column
+// The private key column is left out when none of the accounts hold a private key
+func prettyTable(pathLabel string, addressLabel string, privateKeyLabel string, accounts []KeyAccountJSON) string {
+	hasPrivateKeys := false
+	pathWidth, addressWidth, privateKeyWidth := len(pathLabel), len(addressLabel), 0
+	pathWidth = max(pathWidth, 18)
+	for _, account := range accounts {
+		pathWidth = max(pathWidth, len(account.Path))
+		addressWidth = max(addressWidth, len(account.Address))
+		privateKeyWidth = max(privateKeyWidth, len(account.PrivateKey))
+		hasPrivateKeys = hasPrivateKeys || account.PrivateKey != ""
+	}
+	if hasPrivateKeys {
+		privateKeyWidth = max(privateKeyWidth, len(privateKeyLabel))
+	} else {
+		privateKeyLabel = ""
+	}
+
+	sp := fmt.Sprintf("\n%-*s %-*s %s\n", pathWidth, pathLabel, addressWidth, addressLabel, privateKeyLabel)
+	sp += strings.Repeat("-", pathWidth+addressWidth+privateKeyWidth+2)
+	sp += "\n"
+	for _, account := range accounts {
+		sp += fmt.Sprintf("%-*s %-*s %s\n", pathWidth, account.Path, addressWidth, account.Address, account.PrivateKey)
+	}
+	return sp
+}
@@ -0,0 +1,87 @@
+package bip44
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestExportOptions checks that the account, change and start index options select the paths of every output
+func TestExportOptions(t *testing.T) {
+	km, err := NewKeyManager("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kmj, err := km.Export(ExportOptions{
+		Accounts:   2,
+		Account:    1,
+		Changes:    []Index{ChangeInternal},
+		StartIndex: 5,
+		Purposes:   []Purpose{PurposeBIP84},
+		Coins:      []*Coin{CoinBitcoin, CoinLitecoin},
+		Compress:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paths := func(accounts []KeyAccountJSON) []string {
+		p := make([]string, 0, len(accounts))
+		for _, account := range accounts {
+			p = append(p, account.Path)
+		}
+		return p
+	}
+	tests := []struct {
+		name     string
+		accounts []KeyAccountJSON
+		want     []string
+	}{
+		{"bitcoin", kmj.BitcoinAccounts, []string{"m", "m/84'/0'/1'/1/5", "m/84'/0'/1'/1/6"}},
+		{"ethereum", kmj.EVMAccounts, []string{"m", "m/44'/60'/1'/1/5", "m/44'/60'/1'/1/6"}},
+		{"litecoin", kmj.CoinAccounts[0].Accounts, []string{"m/84'/2'/1'/1/5", "m/84'/2'/1'/1/6"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := paths(test.accounts); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("paths = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+// TestExportAddresses checks the first receive and change addresses of the BIP84 test vectors and the
+// first Ethereum address of the abandon about mnemonic
+// https://github.com/bitcoin/bips/blob/master/bip-0084.mediawiki#test-vectors
+func TestExportAddresses(t *testing.T) {
+	km, err := NewKeyManager("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kmj, err := km.Export(ExportOptions{
+		Accounts: 1,
+		Changes:  []Index{ChangeExternal, ChangeInternal},
+		Purposes: []Purpose{PurposeBIP84},
+		Compress: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addresses := make(map[string]string)
+	for _, account := range append(kmj.BitcoinAccounts, kmj.EVMAccounts...) {
+		addresses[account.Path] = account.Address
+	}
+	tests := []struct {
+		path    string
+		address string
+	}{
+		{"m/84'/0'/0'/0/0", "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"},
+		{"m/84'/0'/0'/1/0", "bc1q8c6fshw2dlwun7ekn9qwf37cu2rn755upcp6el"},
+		{"m/44'/60'/0'/0/0", "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"},
+	}
+	for _, test := range tests {
+		if got := addresses[test.path]; got != test.address {
+			t.Errorf("%s address = %s, want %s", test.path, got, test.address)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Package bip44
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bip44
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+
+	"key-gen/btc"
+)
+
+// PurposeFromVersion returns the network and purpose of extended keys serialized with the SLIP-0132 version bytes
+// xpub and tpub are shared by BIP44 and BIP86, so BIP44 is returned and callers select BIP86 explicitly
+func PurposeFromVersion(version []byte) (*btc.Network, Purpose, error) {
+	network, ok := btc.NetworkOfVersion(version)
+	if !ok {
+		return nil, 0, fmt.Errorf("unsupported extended public key version %x", version)
+	}
+	addressType, _ := network.AddressTypeOfVersion(version)
+	switch addressType {
+	case btc.AddressTypeP2SHP2WPKH:
+		return network, PurposeBIP49, nil
+	case btc.AddressTypeP2WPKH:
+		return network, PurposeBIP84, nil
+	}
+	return network, PurposeBIP44, nil
+}
+
+// Fingerprint returns the key fingerprint, the first 4 bytes of the hash160 of the public key
+func (k *Key) Fingerprint() []byte {
+	return btcutil.Hash160(k.BIP32Key.PublicKey().Key)[:4]
+}
+
+// ExtendedPublicKey returns the neutered key serialized with the given version bytes, e.g. the zpub version
+func (k *Key) ExtendedPublicKey(version []byte) string {
+	pub := k.BIP32Key.PublicKey()
+	pub.Version = version
+	return pub.B58Serialize()
+}
+
+// ExtendedPrivateKey returns the key serialized with the given version bytes, e.g. the tprv version
+func (k *Key) ExtendedPrivateKey(version []byte) string {
+	prv := *k.BIP32Key
+	prv.Version = version
+	return prv.B58Serialize()
+}
+
+// KeyOrigin returns the key origin of the key, the master fingerprint followed by the path without the leading m
+// e.g. [73c5da0a/84'/0'/0']
+func KeyOrigin(masterFingerprint []byte, path string) string {
+	return fmt.Sprintf("[%x%s]", masterFingerprint, path[1:])
+}
+
+// ExtendedKeyJSON is an account extended public key
+// Keys that do not descend from the BIP32 master key, such as Cardano's Icarus keys or a watched xpub, have no key origin
+type ExtendedKeyJSON struct {
+	Path      string `json:"path"`
+	KeyOrigin string `json:"key_origin,omitempty"`
+	PublicKey string `json:"public_key"`
+	KeyType   string `json:"type"`
+}
+
+// extendedKeys returns the account extended public key of every coin and purpose
+// Keys of coins other than Bitcoin are prefixed with the coin name, e.g. Litecoin Ltub(BIP44)
+func (km *KeyManager) extendedKeys(masterFingerprint []byte, opts ExportOptions) ([]ExtendedKeyJSON, error) {
+	keys := make([]ExtendedKeyJSON, 0)
+	for _, coin := range opts.coins() {
+		if coin.Deriver != nil {
+			key, err := coin.Deriver.ExtendedKey(km, opts)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, key)
+			continue
+		}
+		chain, coinType := opts.chain(coin)
+		for _, purpose := range coin.Purposes(opts.Purposes) {
+			addressType, err := purpose.AddressType()
+			if err != nil {
+				return nil, err
+			}
+			key, err := km.AccountKey(purpose, coinType, opts.Account)
+			if err != nil {
+				return nil, err
+			}
+			publicKey := chain.ExtendedPublicKey(key.BIP32Key, addressType)
+			if publicKey == "" {
+				continue
+			}
+			keyType := fmt.Sprintf("%s(BIP%d)", publicKey[:4], uint32(purpose)-Apostrophe)
+			if coin != CoinBitcoin {
+				keyType = fmt.Sprintf("%s %s", coin.Name, keyType)
+			}
+			keys = append(keys, ExtendedKeyJSON{
+				Path:      key.Path,
+				KeyOrigin: KeyOrigin(masterFingerprint, key.Path),
+				PublicKey: publicKey,
+				KeyType:   keyType,
+			})
+		}
+	}
+	return keys, nil
+}
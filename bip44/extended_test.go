@@ -0,0 +1,69 @@
+package bip44
+
+import (
+	"testing"
+
+	"key-gen/btc"
+)
+
+func TestPurposeFromVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version []byte
+		network *btc.Network
+		purpose Purpose
+		wantErr bool
+	}{
+		{"xpub", btc.Mainnet.PublicVersion, btc.Mainnet, PurposeBIP44, false},
+		{"ypub", btc.Mainnet.PublicVersionNested, btc.Mainnet, PurposeBIP49, false},
+		{"zpub", btc.Mainnet.PublicVersionNative, btc.Mainnet, PurposeBIP84, false},
+		{"tpub", btc.Testnet.PublicVersion, btc.Testnet, PurposeBIP44, false},
+		{"upub", btc.Testnet.PublicVersionNested, btc.Testnet, PurposeBIP49, false},
+		{"vpub", btc.Testnet.PublicVersionNative, btc.Testnet, PurposeBIP84, false},
+		{"xprv", []byte{0x04, 0x88, 0xad, 0xe4}, nil, 0, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			network, purpose, err := PurposeFromVersion(test.version)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("PurposeFromVersion() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if network != test.network || purpose != test.purpose {
+				t.Errorf("PurposeFromVersion() = %v, %v, want %v, %v", network, purpose, test.network, test.purpose)
+			}
+		})
+	}
+}
+
+// TestExtendedKeys checks the account extended public keys of the abandon about mnemonic against the BIP84 and
+// BIP86 test vectors and the BIP44 account xpub
+// https://github.com/bitcoin/bips/blob/master/bip-0084.mediawiki#test-vectors
+// https://github.com/bitcoin/bips/blob/master/bip-0086.mediawiki#test-vectors
+func TestExtendedKeys(t *testing.T) {
+	km, err := NewKeyManager("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mainKey, err := km.MainKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := km.extendedKeys(mainKey.Fingerprint(), ExportOptions{Purposes: []Purpose{PurposeBIP44, PurposeBIP84, PurposeBIP86}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []ExtendedKeyJSON{
+		{"m/44'/0'/0'", "[73c5da0a/44'/0'/0']", "xpub6BosfCnifzxcFwrSzQiqu2DBVTshkCXacvNsWGYJVVhhawA7d4R5WSWGFNbi8Aw6ZRc1brxMyWMzG3DSSSSoekkudhUd9yLb6qx39T9nMdj", "xpub(BIP44)"},
+		{"m/84'/0'/0'", "[73c5da0a/84'/0'/0']", "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs", "zpub(BIP84)"},
+		{"m/86'/0'/0'", "[73c5da0a/86'/0'/0']", "xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ", "xpub(BIP86)"},
+	}
+	if len(keys) != len(want) {
+		t.Fatalf("got %d extended keys, want %d", len(keys), len(want))
+	}
+	for i := range want {
+		if keys[i] != want[i] {
+			t.Errorf("extended key %d = %+v, want %+v", i, keys[i], want[i])
+		}
+	}
+}
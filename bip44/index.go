@@ -0,0 +1,138 @@
+// Package bip44
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bip44
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/tyler-smith/go-bip32"
+)
+
+// ErrIndexOutOfRange is returned when a child index does not fit below 2^31
+var ErrIndexOutOfRange = errors.New("index out of range, it must be less than 2^31")
+
+// HardenedIndex is a hardened BIP32 child index, e.g. the 0 of account 0'
+// The value is stored without the 2^31 offset, which is added by ChildIndex
+type HardenedIndex uint32
+
+// Index is a normal (non-hardened) BIP32 child index, e.g. the change and address_index levels
+type Index uint32
+
+// NewHardenedIndex returns the hardened index for i, which must be less than 2^31
+func NewHardenedIndex(i uint32) (HardenedIndex, error) {
+	index := HardenedIndex(i)
+	return index, index.Validate()
+}
+
+// NewIndex returns the normal index for i, which must be less than 2^31
+func NewIndex(i uint32) (Index, error) {
+	index := Index(i)
+	return index, index.Validate()
+}
+
+// Validate checks that the index is less than 2^31
+func (i HardenedIndex) Validate() error {
+	if uint32(i) >= Apostrophe {
+		return fmt.Errorf("hardened %w: %d", ErrIndexOutOfRange, uint32(i))
+	}
+	return nil
+}
+
+// ChildIndex returns the index passed to BIP32 child derivation, i + 2^31
+func (i HardenedIndex) ChildIndex() uint32 {
+	return uint32(i) + Apostrophe
+}
+
+// String returns the index as a path level, e.g. 0'
+func (i HardenedIndex) String() string {
+	return fmt.Sprintf("%d'", uint32(i))
+}
+
+// Validate checks that the index is less than 2^31
+func (i Index) Validate() error {
+	if uint32(i) >= Apostrophe {
+		return fmt.Errorf("normal %w: %d", ErrIndexOutOfRange, uint32(i))
+	}
+	return nil
+}
+
+// ChildIndex returns the index passed to BIP32 child derivation
+func (i Index) ChildIndex() uint32 {
+	return uint32(i)
+}
+
+// String returns the index as a path level, e.g. 0
+func (i Index) String() string {
+	return fmt.Sprintf("%d", uint32(i))
+}
+
+// deriveChild derives the child of parent at index
+// https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki#private-parent-key--private-child-key
+// When parse256(IL) >= n or the resulting key is invalid, BIP32 says to proceed with the next index,
+// which never crosses from the normal into the hardened range.
+func deriveChild(parent *bip32.Key, index uint32) (*bip32.Key, error) {
+	for {
+		if validIntermediary(parent, index) {
+			child, err := parent.NewChildKey(index)
+			if err == nil {
+				return child, nil
+			}
+			if !errors.Is(err, bip32.ErrInvalidPrivateKey) && !errors.Is(err, bip32.ErrInvalidPublicKey) {
+				return nil, err
+			}
+		}
+		if index+1 == Apostrophe || index+1 == 0 {
+			return nil, fmt.Errorf("no valid child key left after index %d", index)
+		}
+		index++
+	}
+}
+
+// validIntermediary reports whether the left half of the child HMAC, IL, is less than the curve order n
+func validIntermediary(parent *bip32.Key, index uint32) bool {
+	var data []byte
+	switch {
+	case index >= Apostrophe:
+		data = append([]byte{0x0}, parent.Key...)
+	case parent.IsPrivate:
+		_, pubKey := btcec.PrivKeyFromBytes(parent.Key)
+		data = pubKey.SerializeCompressed()
+	default:
+		data = append([]byte{}, parent.Key...)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	mac := hmac.New(sha512.New, parent.ChainCode)
+	mac.Write(data)
+	il := new(big.Int).SetBytes(mac.Sum(nil)[:32])
+	return il.Cmp(btcec.S256().N) < 0
+}
+
+// HardenedAccountChanged reports whether earlier versions of key-gen printed the path for a different key.
+// They only derived account 0 of Bitcoin, on every purpose, and of Ethereum, on BIP44, and did so without hardening
+// the account level, so the key they printed as m/44'/0'/0'/0/0 is the one now found at m/44'/0'/0/0/0.
+func HardenedAccountChanged(indices []uint32) bool {
+	if len(indices) < 3 || indices[2] != Apostrophe {
+		return false
+	}
+	purpose, coinType := Purpose(indices[0]), CoinType(indices[1])
+	switch coinType {
+	case CoinTypeBitcoin:
+		for _, p := range Purposes {
+			if p == purpose {
+				return true
+			}
+		}
+	case CoinTypeEthereum:
+		return purpose == PurposeBIP44
+	}
+	return false
+}
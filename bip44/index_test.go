@@ -0,0 +1,178 @@
+package bip44
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tyler-smith/go-bip32"
+
+	"key-gen/btc"
+)
+
+func TestNewIndex(t *testing.T) {
+	tests := []struct {
+		i       uint32
+		wantErr bool
+	}{
+		{0, false},
+		{1, false},
+		{Apostrophe - 1, false},
+		{Apostrophe, true},
+		{0xffffffff, true},
+	}
+	for _, test := range tests {
+		_, err := NewIndex(test.i)
+		if (err != nil) != test.wantErr {
+			t.Errorf("NewIndex(%d) error = %v, wantErr %v", test.i, err, test.wantErr)
+		}
+		if test.wantErr && !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("NewIndex(%d) error = %v, want ErrIndexOutOfRange", test.i, err)
+		}
+		_, err = NewHardenedIndex(test.i)
+		if (err != nil) != test.wantErr {
+			t.Errorf("NewHardenedIndex(%d) error = %v, wantErr %v", test.i, err, test.wantErr)
+		}
+		if test.wantErr && !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("NewHardenedIndex(%d) error = %v, want ErrIndexOutOfRange", test.i, err)
+		}
+	}
+}
+
+func TestChildIndex(t *testing.T) {
+	tests := []struct {
+		index interface {
+			ChildIndex() uint32
+			String() string
+		}
+		childIndex uint32
+		level      string
+	}{
+		{Index(0), 0, "0"},
+		{Index(Apostrophe - 1), Apostrophe - 1, "2147483647"},
+		{HardenedIndex(0), Apostrophe, "0'"},
+		{HardenedIndex(Apostrophe - 1), 0xffffffff, "2147483647'"},
+	}
+	for _, test := range tests {
+		if got := test.index.ChildIndex(); got != test.childIndex {
+			t.Errorf("%s ChildIndex() = %d, want %d", test.index, got, test.childIndex)
+		}
+		if got := test.index.String(); got != test.level {
+			t.Errorf("String() = %s, want %s", got, test.level)
+		}
+	}
+}
+
+// TestDeriveChild checks that deriveChild matches plain BIP32 derivation whenever IL is a valid key
+func TestDeriveChild(t *testing.T) {
+	master, err := bip32.NewMasterKey(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, index := range []uint32{0, 1, Apostrophe - 1, Apostrophe, 0xffffffff} {
+		want, err := master.NewChildKey(index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !validIntermediary(master, index) {
+			t.Errorf("validIntermediary(%d) = false, want true", index)
+		}
+		got, err := deriveChild(master, index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.B58Serialize() != want.B58Serialize() {
+			t.Errorf("deriveChild(%d) = %s, want %s", index, got.B58Serialize(), want.B58Serialize())
+		}
+	}
+}
+
+// TestAccountKeyHardened checks the BIP44 account key and first address of the abandon about mnemonic, derived at m/44'/0'/0'
+func TestAccountKeyHardened(t *testing.T) {
+	km, err := NewKeyManager("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err := km.AccountKey(PurposeBIP44, CoinTypeBitcoin, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.Path != "m/44'/0'/0'" {
+		t.Errorf("path = %s, want m/44'/0'/0'", account.Path)
+	}
+	xpub := "xpub6BosfCnifzxcFwrSzQiqu2DBVTshkCXacvNsWGYJVVhhawA7d4R5WSWGFNbi8Aw6ZRc1brxMyWMzG3DSSSSoekkudhUd9yLb6qx39T9nMdj"
+	if got := account.BIP32Key.PublicKey().B58Serialize(); got != xpub {
+		t.Errorf("xpub = %s, want %s", got, xpub)
+	}
+
+	key, err := km.Key(PurposeBIP44, CoinTypeBitcoin, 0, ChangeExternal, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wif, err := key.NewWIF(btc.Mainnet, true, btc.AddressTypeP2PKH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wif.Address != "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA" {
+		t.Errorf("address = %s, want 1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA", wif.Address)
+	}
+
+	if _, err := km.AccountKey(PurposeBIP44, CoinTypeBitcoin, HardenedIndex(Apostrophe)); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("AccountKey(2^31) error = %v, want ErrIndexOutOfRange", err)
+	}
+	if _, err := km.Key(PurposeBIP44, CoinTypeBitcoin, 0, ChangeExternal, Index(Apostrophe)); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Key(index 2^31) error = %v, want ErrIndexOutOfRange", err)
+	}
+}
+
+func TestHardenedAccountChanged(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"m/44'/0'/0'/0/0", true},
+		{"m/84'/0'/0'", true},
+		{"m/86'/0'/0'/1/5", true},
+		{"m/44'/60'/0'/0/0", true},
+		{"m/84'/60'/0'/0/0", false},
+		{"m/44'/0'/1'/0/0", false},
+		{"m/44'/0'/0/0/0", false},
+		{"m/44'/0'", false},
+		{"m/44'/2'/0'/0/0", false},
+		{"m/44'/501'/0'/0'", false},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			indices, err := ParsePath(test.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := HardenedAccountChanged(indices); got != test.want {
+				t.Errorf("HardenedAccountChanged(%s) = %v, want %v", test.path, got, test.want)
+			}
+		})
+	}
+}
+
+func TestExportOptionsHardenedAccountChanged(t *testing.T) {
+	external := []Index{ChangeExternal}
+	tests := []struct {
+		name string
+		opts ExportOptions
+		want bool
+	}{
+		{"defaults", ExportOptions{Changes: external}, true},
+		{"both chains", ExportOptions{Changes: []Index{ChangeExternal, ChangeInternal}}, true},
+		{"non-zero account", ExportOptions{Account: 1, Changes: external}, false},
+		{"internal chain", ExportOptions{Changes: []Index{ChangeInternal}}, false},
+		{"bitcoin testnet", ExportOptions{Changes: external, Network: btc.Testnet, EVMChains: []*EVMChain{EVMChainRSK}}, false},
+		{"ethereum only", ExportOptions{Changes: external, Coins: []*Coin{CoinLitecoin}}, true},
+		{"neither", ExportOptions{Changes: external, Coins: []*Coin{CoinLitecoin}, EVMChains: []*EVMChain{EVMChainRSK}}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.opts.HardenedAccountChanged(); got != test.want {
+				t.Errorf("HardenedAccountChanged() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
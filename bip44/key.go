@@ -7,6 +7,7 @@ package bip44
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"strings"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/ethereum/go-ethereum/common"
@@ -14,6 +15,7 @@ import (
 	"github.com/tyler-smith/go-bip32"
 
 	"key-gen/btc"
+	"key-gen/tron"
 )
 
 type Key struct {
@@ -35,10 +37,15 @@ func NewKey(path string, key *bip32.Key) *Key {
 	}
 }
 
-// NewWIF Transforms the key into a WIF
-func (k *Key) NewWIF(compress bool) (*btc.WIF, error) {
+// TronAddress returns the Tron address of the key, the EVM address Base58Check-encoded with the 0x41 prefix
+func (k *Key) TronAddress() string {
+	return tron.EncodeAddress(k.EVMAddress)
+}
+
+// NewWIF Transforms the key into a WIF and the address of the given type on the network
+func (k *Key) NewWIF(network *btc.Network, compress bool, addressType btc.AddressType) (*btc.WIF, error) {
 	prvKey, _ := btcec.PrivKeyFromBytes(k.BIP32Key.Key)
-	return btc.FromPrivateKey(prvKey, compress)
+	return btc.FromPrivateKey(prvKey, network, compress, addressType)
 }
 
 // HexKey returns the key as a hex string
@@ -50,3 +57,41 @@ func (k *Key) HexKey() string {
 func (k *Key) Base58Key() string {
 	return k.BIP32Key.B58Serialize()
 }
+
+// ToPrettyString returns every address format for the key, omitting the private material when suppress is set
+// Address types that cannot be used with uncompressed keys are left out when compress is false
+func (k *Key) ToPrettyString(network *btc.Network, compress bool, suppress bool) (string, error) {
+	prvKey, pubKey := btcec.PrivKeyFromBytes(k.BIP32Key.Key)
+	serializedPubKey := pubKey.SerializeUncompressed()
+	if compress {
+		serializedPubKey = pubKey.SerializeCompressed()
+	}
+
+	sp := strings.Repeat("-", 106)
+	sp += fmt.Sprintf("\n%-32s %s\n", "Path:", k.Path)
+	sp += fmt.Sprintf("%-32s %s\n", "Network:", network.Name)
+	sp += fmt.Sprintf("%-32s %x\n", "Public Key:", serializedPubKey)
+	for _, addressType := range btc.AddressTypes {
+		if addressType.Validate(compress) != nil {
+			continue
+		}
+		address, err := btc.NewAddress(pubKey, network, compress, addressType)
+		if err != nil {
+			return "", err
+		}
+		sp += fmt.Sprintf("%-32s %s\n", addressType.Label(compress)+":", address)
+	}
+	sp += fmt.Sprintf("%-32s %s\n", "Ethereum(EIP55):", k.EVMAddress)
+	sp += fmt.Sprintf("%-32s %s\n", "Tron(Base58Check):", k.TronAddress())
+	if !suppress {
+		wif, err := btc.FromPrivateKey(prvKey, network, compress, btc.AddressTypeP2PKH)
+		if err != nil {
+			return "", err
+		}
+		sp += fmt.Sprintf("%-32s %s\n", "BIP32 Extended Key:", k.ExtendedPrivateKey(network.PrivateVersion()))
+		sp += fmt.Sprintf("%-32s %s\n", "WIF(Wallet Import Format):", wif.WIFString)
+		sp += fmt.Sprintf("%-32s %s\n", "Private Key(hex):", k.HexKey())
+	}
+	sp += "\n"
+	return sp, nil
+}
@@ -0,0 +1,60 @@
+// Package bip44
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bip44
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParsePath parses a BIP32 derivation path such as m/44'/0'/0'/0/1 into its child indices.
+// Hardened levels can be marked with an apostrophe ('), h or H, so m/0h/0h/5h and m/0'/0'/5' are equivalent.
+// The leading m is required and an empty index list is returned for the master key.
+func ParsePath(path string) ([]uint32, error) {
+	levels := strings.Split(strings.TrimSpace(path), "/")
+	if levels[0] != "m" && levels[0] != "M" {
+		return nil, fmt.Errorf("invalid path %q: a path must start with m", path)
+	}
+
+	indices := make([]uint32, 0, len(levels)-1)
+	for _, level := range levels[1:] {
+		hardened := false
+		if strings.HasSuffix(level, "'") || strings.HasSuffix(level, "h") || strings.HasSuffix(level, "H") {
+			hardened = true
+			level = level[:len(level)-1]
+		}
+		if level == "" {
+			return nil, fmt.Errorf("invalid path %q: empty level", path)
+		}
+		index, err := strconv.ParseUint(level, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path %q: %w", path, err)
+		}
+		if uint32(index) >= Apostrophe {
+			return nil, fmt.Errorf("invalid path %q: index %d is out of range, it must be less than 2^31", path, index)
+		}
+		if hardened {
+			index += uint64(Apostrophe)
+		}
+		indices = append(indices, uint32(index))
+	}
+	return indices, nil
+}
+
+// FormatPath returns the canonical path for the given child indices, using an apostrophe for hardened levels.
+// The result matches the paths produced by the KeyManager ladder, so both share the same key cache entries.
+func FormatPath(indices []uint32) string {
+	var sb strings.Builder
+	sb.WriteString("m")
+	for _, index := range indices {
+		if index >= Apostrophe {
+			sb.WriteString(fmt.Sprintf("/%d'", index-Apostrophe))
+		} else {
+			sb.WriteString(fmt.Sprintf("/%d", index))
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,134 @@
+package bip44
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+
+	"github.com/tyler-smith/go-bip32"
+)
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		path    string
+		indices []uint32
+		wantErr bool
+	}{
+		{"m", []uint32{}, false},
+		{"M", []uint32{}, false},
+		{" m/0 ", []uint32{0}, false},
+		{"m/44'/0'/0'/0/1", []uint32{44 + Apostrophe, Apostrophe, Apostrophe, 0, 1}, false},
+		{"m/44h/501h/0h/0h", []uint32{44 + Apostrophe, 501 + Apostrophe, Apostrophe, Apostrophe}, false},
+		{"m/44H/0H/5H", []uint32{44 + Apostrophe, Apostrophe, 5 + Apostrophe}, false},
+		{"m/0'/2147483647/2147483647'", []uint32{Apostrophe, 2147483647, 0xffffffff}, false},
+		{"", nil, true},
+		{"44'/0'", nil, true},
+		{"m/", nil, true},
+		{"m//0", nil, true},
+		{"m/'", nil, true},
+		{"m/0''", nil, true},
+		{"m/-1", nil, true},
+		{"m/0x1", nil, true},
+		{"m/2147483648", nil, true},
+		{"m/2147483648'", nil, true},
+		{"m/4294967296", nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			indices, err := ParsePath(test.path)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ParsePath(%q) error = %v, wantErr %v", test.path, err, test.wantErr)
+			}
+			if !test.wantErr && !reflect.DeepEqual(indices, test.indices) {
+				t.Errorf("ParsePath(%q) = %v, want %v", test.path, indices, test.indices)
+			}
+		})
+	}
+}
+
+// TestFormatPath checks that every hardened marker formats to the apostrophe paths the KeyManager ladder caches under
+func TestFormatPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"m", "m"},
+		{"M/0", "m/0"},
+		{"m/44'/0'/0'/0/1", "m/44'/0'/0'/0/1"},
+		{"m/44h/0H/0'/1/0", "m/44'/0'/0'/1/0"},
+		{"m/2147483647'/2147483647", "m/2147483647'/2147483647"},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			indices, err := ParsePath(test.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := FormatPath(indices); got != test.want {
+				t.Errorf("FormatPath(ParsePath(%q)) = %s, want %s", test.path, got, test.want)
+			}
+		})
+	}
+}
+
+// TestDeriveKeyByPath checks the extended keys of BIP32 test vector 1
+// https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki#test-vector-1
+func TestDeriveKeyByPath(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	master, err := bip32.NewMasterKey(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	km, err := NewKeyManager("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the test vector starts from a seed rather than a mnemonic, so its master key is cached in place of the mnemonic's
+	km.SetKey("m", master)
+
+	tests := []struct {
+		path string
+		xpub string
+		xprv string
+	}{
+		{"m/0h", "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw", "xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7"},
+		{"m/0'/1", "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ", "xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8fs"},
+		{"m/0H/1/2'/2/1000000000", "xpub6H1LXWLaKsWFhvm6RVpEL9P4KfRZSW7abD2ttkWP3SSQvnyA8FSVqNTEcYFgJS2UaFcxupHiYkro49S8yGasTvXEYBVPamhGW6cFJodrTHy", "xprvA41z7zogVVwxVSgdKUHDy1SKmdb533PjDz7J6N6mV6uS3ze1ai8FHa8kmHScGpWmj4WggLyQjgPie1rFSruoUihUZREPSL39UNdE3BBDu76"},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			key, err := km.DeriveKeyByPath(test.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := key.BIP32Key.PublicKey().B58Serialize(); got != test.xpub {
+				t.Errorf("xpub = %s, want %s", got, test.xpub)
+			}
+			if got := key.Base58Key(); got != test.xprv {
+				t.Errorf("xprv = %s, want %s", got, test.xprv)
+			}
+		})
+	}
+}
+
+// TestDeriveKeyByPathCache checks that a path with h markers resolves to the key the BIP44 ladder caches
+func TestDeriveKeyByPathCache(t *testing.T) {
+	km, err := NewKeyManager("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ladder, err := km.Key(PurposeBIP44, CoinTypeBitcoin, 0, ChangeExternal, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := km.DeriveKeyByPath("m/44h/0h/0h/0/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Path != ladder.Path {
+		t.Errorf("path = %s, want %s", key.Path, ladder.Path)
+	}
+	if key.BIP32Key != ladder.BIP32Key {
+		t.Error("DeriveKeyByPath derived a new key instead of using the cached ladder key")
+	}
+}
@@ -0,0 +1,83 @@
+// Package bip44
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bip44
+
+import (
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+// SecondaryAddresser is implemented by chains where every key also controls a second kind of address
+// e.g. the Avalanche P-chain next to the X-chain, or Bitcoin Cash addresses without their prefix
+type SecondaryAddresser interface {
+	SecondaryLabel(compress bool) string
+	SecondaryAddress(pubKey *btcec.PublicKey, compress bool) (string, error)
+}
+
+// TaggedAddresser is implemented by chains whose addresses can also carry a destination tag, e.g. XRP X-addresses
+// Tagged addresses encode the same account as the chain's address, so their rows carry no private key
+type TaggedAddresser interface {
+	TaggedLabel(tag *uint32) string
+	TaggedAddress(pubKey *btcec.PublicKey, tag *uint32) (string, error)
+}
+
+// secondaryAccounts returns the second addresses of the selected account, change chains and indices
+// The primary addresses of the same keys are exported through the coin's chain, so both come out of one run
+func (km *KeyManager) secondaryAccounts(coin *Coin, opts ExportOptions) ([]KeyAccountJSON, error) {
+	chain, coinType := opts.chain(coin)
+	secondary, ok := chain.(SecondaryAddresser)
+	if !ok {
+		return nil, nil
+	}
+	accounts := make([]KeyAccountJSON, 0)
+	for _, pair := range opts.indices() {
+		key, err := km.Key(PurposeBIP44, coinType, opts.Account, pair[0], pair[1])
+		if err != nil {
+			return nil, err
+		}
+		prvKey, pubKey := btcec.PrivKeyFromBytes(key.Key)
+		address, err := secondary.SecondaryAddress(pubKey, opts.Compress)
+		if err != nil {
+			return nil, err
+		}
+		privateKey, err := chain.PrivateKey(prvKey, opts.Compress)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, KeyAccountJSON{
+			Path:       key.Path,
+			Address:    address,
+			PrivateKey: privateKey,
+			KeyType:    secondary.SecondaryLabel(opts.Compress),
+		})
+	}
+	return accounts, nil
+}
+
+// taggedAccounts returns the tagged addresses of the selected account, change chains and indices, for chains implementing TaggedAddresser
+func (km *KeyManager) taggedAccounts(coin *Coin, opts ExportOptions) ([]KeyAccountJSON, error) {
+	chain, coinType := opts.chain(coin)
+	tagged, ok := chain.(TaggedAddresser)
+	if !ok {
+		return nil, nil
+	}
+	accounts := make([]KeyAccountJSON, 0)
+	for _, pair := range opts.indices() {
+		key, err := km.Key(PurposeBIP44, coinType, opts.Account, pair[0], pair[1])
+		if err != nil {
+			return nil, err
+		}
+		_, pubKey := btcec.PrivKeyFromBytes(key.Key)
+		address, err := tagged.TaggedAddress(pubKey, opts.XRPTag)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, KeyAccountJSON{
+			Path:    key.Path,
+			Address: address,
+			KeyType: tagged.TaggedLabel(opts.XRPTag),
+		})
+	}
+	return accounts, nil
+}
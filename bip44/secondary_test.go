@@ -0,0 +1,90 @@
+package bip44
+
+import (
+	"testing"
+)
+
+// TestTaggedAccounts checks that XRP exports its X-addresses next to the classic addresses, without private keys
+func TestTaggedAccounts(t *testing.T) {
+	km, err := NewKeyManager("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag := uint32(11747)
+	kmj, err := km.Export(ExportOptions{
+		Accounts: 1,
+		Changes:  []Index{ChangeExternal},
+		Coins:    []*Coin{CoinXRP},
+		XRPTag:   &tag,
+		Compress: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kmj.XRPAccounts) != 2 {
+		t.Fatalf("got %d XRP accounts, want 2", len(kmj.XRPAccounts))
+	}
+	classic, tagged := kmj.XRPAccounts[0], kmj.XRPAccounts[1]
+	if classic.Address != "rHsMGQEkVNJmpGWs8XUBoTBiAAbwxZN5v3" || classic.PrivateKey == "" {
+		t.Errorf("classic account = %+v, want rHsMGQEkVNJmpGWs8XUBoTBiAAbwxZN5v3 with its private key", classic)
+	}
+	if tagged.Path != classic.Path || tagged.Address[0] != 'X' || tagged.PrivateKey != "" {
+		t.Errorf("tagged account = %+v, want an X-address at %s without a private key", tagged, classic.Path)
+	}
+	if want := "X-address(tag 11747)"; tagged.KeyType != want {
+		t.Errorf("tagged type = %s, want %s", tagged.KeyType, want)
+	}
+}
+
+// TestSecondaryAccounts checks that chains export the second address of each key after the first, with the same private key
+// Avalanche adds the P-chain address to the X-chain one and Bitcoin Cash the prefix-less address
+func TestSecondaryAccounts(t *testing.T) {
+	km, err := NewKeyManager("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type account struct {
+		address string
+		keyType string
+	}
+	tests := []struct {
+		coin     *Coin
+		path     string
+		accounts []account
+	}{
+		{CoinAvalanche, "m/44'/9000'/0'/0/0", []account{
+			{"X-avax1p9575chzhvcwvmvzaqh7yeld76r3af0ha56phl", "X-Chain(bech32)"},
+			{"P-avax1p9575chzhvcwvmvzaqh7yeld76r3af0ha56phl", "P-Chain(bech32)"},
+		}},
+		{CoinBitcoinCash, "m/44'/145'/0'/0/0", []account{
+			{"bitcoincash:qqyx49mu0kkn9ftfj6hje6g2wfer34yfnq5tahq3q6", "CashAddr(P2PKH, compressed)"},
+			{"qqyx49mu0kkn9ftfj6hje6g2wfer34yfnq5tahq3q6", "CashAddr(P2PKH, compressed, no prefix)"},
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.coin.Name, func(t *testing.T) {
+			kmj, err := km.Export(ExportOptions{
+				Accounts: 1,
+				Changes:  []Index{ChangeExternal},
+				Coins:    []*Coin{test.coin},
+				Compress: true,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(kmj.CoinAccounts) != 1 || len(kmj.CoinAccounts[0].Accounts) != len(test.accounts) {
+				t.Fatalf("got %+v, want one %s coin with %d accounts", kmj.CoinAccounts, test.coin.Name, len(test.accounts))
+			}
+			accounts := kmj.CoinAccounts[0].Accounts
+			for i, want := range test.accounts {
+				got := accounts[i]
+				if got.Path != test.path || got.Address != want.address || got.KeyType != want.keyType {
+					t.Errorf("account %d = %+v, want %s %s at %s", i, got, want.keyType, want.address, test.path)
+				}
+				if got.PrivateKey != accounts[0].PrivateKey {
+					t.Errorf("account %d private key = %s, want the first key %s", i, got.PrivateKey, accounts[0].PrivateKey)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Package bip44
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package bip44
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/tyler-smith/go-bip32"
+
+	"key-gen/btc"
+)
+
+// WatchOnlyManager derives receive and change addresses from an account extended public key
+// It never holds private material, so only the non-hardened change and address_index levels can be derived
+type WatchOnlyManager struct {
+	ExtendedKey string
+	Purpose     Purpose
+	Network     *btc.Network
+	AccountPath string
+	keys        map[string]*bip32.Key
+	mux         sync.Mutex
+}
+
+// PublicKey is a non-hardened child of a WatchOnlyManager account key
+type PublicKey struct {
+	Path     string
+	BIP32Key *bip32.Key
+}
+
+// NewWatchOnlyManager returns a watch-only manager for an account xpub, ypub or zpub, or tpub, upub or vpub
+// The purpose is taken from the SLIP-0132 version bytes unless one is given, which is needed for BIP86 xpubs
+// The network is taken from the version bytes as well, signet and regtest keys must be given their network
+func NewWatchOnlyManager(extendedKey string, purpose Purpose, network *btc.Network) (*WatchOnlyManager, error) {
+	key, err := bip32.B58Deserialize(extendedKey)
+	if err != nil {
+		return nil, err
+	}
+	if key.IsPrivate {
+		return nil, fmt.Errorf("a public extended key is required, private extended keys are not accepted")
+	}
+	if _, err := btcec.ParsePubKey(key.Key); err != nil {
+		return nil, err
+	}
+
+	versionNetwork, versionPurpose, err := PurposeFromVersion(key.Version)
+	if err != nil {
+		return nil, err
+	}
+	if network == nil {
+		network = versionNetwork
+	}
+	if _, ok := network.AddressTypeOfVersion(key.Version); !ok {
+		return nil, fmt.Errorf("the extended key version does not match network %s", network.Name)
+	}
+	if purpose == 0 {
+		purpose = versionPurpose
+	}
+	if purpose != versionPurpose && !(versionPurpose == PurposeBIP44 && purpose == PurposeBIP86) {
+		return nil, fmt.Errorf("the extended key version does not match purpose %d'", uint32(purpose)-Apostrophe)
+	}
+
+	// Account keys sit at depth 3, m / purpose' / coin_type' / account', so the path can be restored from the child number
+	// Keys at any other depth are shown relative to the extended key
+	accountPath := "M"
+	childNumber := binary.BigEndian.Uint32(key.ChildNumber)
+	if key.Depth == 3 && childNumber >= Apostrophe {
+		accountPath = fmt.Sprintf("m/%d'/%d'/%d'", uint32(purpose)-Apostrophe, uint32(NetworkCoinType(network))-Apostrophe, childNumber-Apostrophe)
+	}
+
+	wm := &WatchOnlyManager{
+		ExtendedKey: extendedKey,
+		Purpose:     purpose,
+		Network:     network,
+		AccountPath: accountPath,
+		keys:        map[string]*bip32.Key{accountPath: key},
+	}
+	return wm, nil
+}
+
+// GetKey returns the key for the given path
+func (wm *WatchOnlyManager) GetKey(path string) (*bip32.Key, bool) {
+	wm.mux.Lock()
+	defer wm.mux.Unlock()
+
+	key, ok := wm.keys[path]
+	return key, ok
+}
+
+// SetKey sets the key for the given path
+func (wm *WatchOnlyManager) SetKey(path string, key *bip32.Key) {
+	wm.mux.Lock()
+	defer wm.mux.Unlock()
+
+	wm.keys[path] = key
+}
+
+// AccountKey returns the account key the manager was created from
+func (wm *WatchOnlyManager) AccountKey() *PublicKey {
+	key, _ := wm.GetKey(wm.AccountPath)
+	return &PublicKey{wm.AccountPath, key}
+}
+
+// ChangeKey returns the public key of the external or internal chain
+func (wm *WatchOnlyManager) ChangeKey(change Index) (*PublicKey, error) {
+	if err := change.Validate(); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("%s/%s", wm.AccountPath, change)
+
+	key, ok := wm.GetKey(path)
+	if ok {
+		return &PublicKey{path, key}, nil
+	}
+
+	key, err := deriveChild(wm.AccountKey().BIP32Key, change.ChildIndex())
+	if err != nil {
+		return nil, err
+	}
+
+	wm.SetKey(path, key)
+
+	return &PublicKey{path, key}, nil
+}
+
+// Key returns the public key for the given change and address index
+func (wm *WatchOnlyManager) Key(change Index, index Index) (*PublicKey, error) {
+	if err := index.Validate(); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("%s/%s/%s", wm.AccountPath, change, index)
+
+	key, ok := wm.GetKey(path)
+	if ok {
+		return &PublicKey{path, key}, nil
+	}
+
+	parent, err := wm.ChangeKey(change)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err = deriveChild(parent.BIP32Key, index.ChildIndex())
+	if err != nil {
+		return nil, err
+	}
+
+	wm.SetKey(path, key)
+
+	return &PublicKey{path, key}, nil
+}
+
+// Address returns the address of the given type paying to the public key on the network
+func (k *PublicKey) Address(network *btc.Network, compress bool, addressType btc.AddressType) (string, error) {
+	pubKey, err := btcec.ParsePubKey(k.BIP32Key.Key)
+	if err != nil {
+		return "", err
+	}
+	return btc.NewAddress(pubKey, network, compress, addressType)
+}
+
+// Export derives the addresses selected by the options, the account level, purposes and network of the options are ignored
+func (wm *WatchOnlyManager) Export(opts ExportOptions) (*KeyManagerJSON, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	addressType, err := wm.Purpose.AddressType()
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]KeyAccountJSON, 0)
+	for _, pair := range opts.indices() {
+		key, err := wm.Key(pair[0], pair[1])
+		if err != nil {
+			return nil, err
+		}
+		address, err := key.Address(wm.Network, opts.Compress, addressType)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, KeyAccountJSON{
+			Path:    key.Path,
+			Address: address,
+			KeyType: addressType.Label(opts.Compress),
+		})
+	}
+
+	return &KeyManagerJSON{
+		Network: wm.Network.Name,
+		ExtendedKeys: []ExtendedKeyJSON{{
+			Path:      wm.AccountPath,
+			PublicKey: wm.ExtendedKey,
+			KeyType:   fmt.Sprintf("%s(BIP%d)", wm.ExtendedKey[:4], uint32(wm.Purpose)-Apostrophe),
+		}},
+		BitcoinAccounts: accounts,
+	}, nil
+}
+
+// ToJSON returns the watch-only addresses as a JSON string
+func (wm *WatchOnlyManager) ToJSON(opts ExportOptions) (string, error) {
+	kmj, err := wm.Export(opts)
+	if err != nil {
+		return "", err
+	}
+	return kmj.ToJSON()
+}
+
+// ToPrettyString returns the watch-only addresses as human-readable tables
+func (wm *WatchOnlyManager) ToPrettyString(opts ExportOptions) (string, error) {
+	kmj, err := wm.Export(opts)
+	if err != nil {
+		return "", err
+	}
+	return kmj.ToPrettyString(), nil
+}
@@ -0,0 +1,77 @@
+package bip44
+
+import (
+	"testing"
+
+	"key-gen/btc"
+)
+
+// TestWatchOnlyManager checks the addresses derived from the account keys of the abandon about mnemonic against the
+// BIP84 and BIP86 test vectors and the first BIP44 address
+// https://github.com/bitcoin/bips/blob/master/bip-0084.mediawiki#test-vectors
+// https://github.com/bitcoin/bips/blob/master/bip-0086.mediawiki#test-vectors
+func TestWatchOnlyManager(t *testing.T) {
+	tests := []struct {
+		name        string
+		extendedKey string
+		purpose     Purpose
+		path        string
+		address     string
+	}{
+		{"BIP44", "xpub6BosfCnifzxcFwrSzQiqu2DBVTshkCXacvNsWGYJVVhhawA7d4R5WSWGFNbi8Aw6ZRc1brxMyWMzG3DSSSSoekkudhUd9yLb6qx39T9nMdj", 0, "m/44'/0'/0'/0/0", "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA"},
+		{"BIP84", "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs", 0, "m/84'/0'/0'/0/0", "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"},
+		{"BIP86", "xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ", PurposeBIP86, "m/86'/0'/0'/0/0", "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wm, err := NewWatchOnlyManager(test.extendedKey, test.purpose, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			kmj, err := wm.Export(ExportOptions{Accounts: 1, Changes: []Index{ChangeExternal}, Compress: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(kmj.BitcoinAccounts) != 1 {
+				t.Fatalf("got %d accounts, want 1", len(kmj.BitcoinAccounts))
+			}
+			account := kmj.BitcoinAccounts[0]
+			if account.Path != test.path || account.Address != test.address {
+				t.Errorf("account = %s %s, want %s %s", account.Path, account.Address, test.path, test.address)
+			}
+			if account.PrivateKey != "" {
+				t.Errorf("watch-only account has a private key")
+			}
+		})
+	}
+}
+
+func TestNewWatchOnlyManagerErrors(t *testing.T) {
+	const zpub = "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs"
+	km, err := NewKeyManager("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err := km.AccountKey(PurposeBIP84, CoinTypeBitcoin, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name        string
+		extendedKey string
+		purpose     Purpose
+		network     *btc.Network
+	}{
+		{"private key", account.Base58Key(), 0, nil},
+		{"purpose mismatch", zpub, PurposeBIP44, nil},
+		{"network mismatch", zpub, 0, btc.Testnet},
+		{"malformed", "zpub", 0, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := NewWatchOnlyManager(test.extendedKey, test.purpose, test.network); err == nil {
+				t.Error("NewWatchOnlyManager() error = nil, want an error")
+			}
+		})
+	}
+}
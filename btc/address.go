@@ -0,0 +1,137 @@
+// Package btc
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package btc
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+)
+
+// AddressType is the output script type an address pays to
+type AddressType int
+
+const (
+	AddressTypeP2PKH      AddressType = iota // Legacy pay-to-pubkey-hash, BIP44
+	AddressTypeP2SHP2WPKH                    // SegWit pay-to-witness-pubkey-hash nested in pay-to-script-hash, BIP49
+	AddressTypeP2WPKH                        // SegWit native pay-to-witness-pubkey-hash, BIP84
+	AddressTypeP2TR                          // Taproot pay-to-taproot key path, BIP86
+)
+
+// AddressTypes lists every address type in purpose order
+var AddressTypes = []AddressType{AddressTypeP2PKH, AddressTypeP2SHP2WPKH, AddressTypeP2WPKH, AddressTypeP2TR}
+
+// String returns the label used for the address type in every output
+func (t AddressType) String() string {
+	switch t {
+	case AddressTypeP2PKH:
+		return "Legacy(P2PKH)"
+	case AddressTypeP2SHP2WPKH:
+		return "SegWit(P2WPKH-nested-in-P2SH)"
+	case AddressTypeP2WPKH:
+		return "SegWit(P2WPKH, bech32)"
+	case AddressTypeP2TR:
+		return "Taproot(P2TR, bech32m)"
+	}
+	return fmt.Sprintf("AddressType(%d)", int(t))
+}
+
+// Label returns the address type label, noting whether a legacy key is compressed
+func (t AddressType) Label(compress bool) string {
+	if t != AddressTypeP2PKH {
+		return t.String()
+	}
+	if compress {
+		return "Legacy(P2PKH, compressed)"
+	}
+	return "Legacy(P2PKH, uncompressed)"
+}
+
+// IsSegWit reports whether the address type spends through a witness program
+func (t AddressType) IsSegWit() bool {
+	return t == AddressTypeP2SHP2WPKH || t == AddressTypeP2WPKH || t == AddressTypeP2TR
+}
+
+// Validate rejects combinations the address type cannot be spent with
+// BIP143 only allows compressed public keys in witness programs, and Taproot uses x-only keys
+func (t AddressType) Validate(compress bool) error {
+	switch t {
+	case AddressTypeP2PKH, AddressTypeP2SHP2WPKH, AddressTypeP2WPKH, AddressTypeP2TR:
+	default:
+		return fmt.Errorf("unsupported address type %d", int(t))
+	}
+	if !compress && t.IsSegWit() {
+		return fmt.Errorf("%s requires compressed public keys", t)
+	}
+	return nil
+}
+
+// DescriptorScript returns the BIP381-386 script expression of the address type, with %s standing for the key expression
+func (t AddressType) DescriptorScript() string {
+	switch t {
+	case AddressTypeP2SHP2WPKH:
+		return "sh(wpkh(%s))"
+	case AddressTypeP2WPKH:
+		return "wpkh(%s)"
+	case AddressTypeP2TR:
+		return "tr(%s)"
+	default:
+		return "pkh(%s)"
+	}
+}
+
+// NewAddress returns the address of the given type paying to the public key on the network
+func NewAddress(pubKey *btcec.PublicKey, network *Network, compress bool, addressType AddressType) (string, error) {
+	if err := addressType.Validate(compress); err != nil {
+		return "", err
+	}
+	if !network.Supports(addressType) {
+		return "", fmt.Errorf("%s is not supported on %s", addressType, network.Name)
+	}
+
+	serializedPubKey := pubKey.SerializeUncompressed()
+	if compress {
+		serializedPubKey = pubKey.SerializeCompressed()
+	}
+	pubKeyHash := btcutil.Hash160(serializedPubKey)
+
+	var address btcutil.Address
+	var err error
+	switch addressType {
+	case AddressTypeP2PKH:
+		// generate a p2pkh address from the pubkey hash
+		address, err = btcutil.NewAddressPubKeyHash(pubKeyHash, network.Params)
+	case AddressTypeP2SHP2WPKH:
+		// generate an address which is
+		// backwards compatible to Bitcoin nodes running 0.6.0 onwards, but
+		// allows us to take advantage of segwit's scripting improvements,
+		// and malleability fixes.
+		var witness *btcutil.AddressWitnessPubKeyHash
+		witness, err = btcutil.NewAddressWitnessPubKeyHash(pubKeyHash, network.Params)
+		if err != nil {
+			return "", err
+		}
+		var serializedScript []byte
+		serializedScript, err = txscript.PayToAddrScript(witness)
+		if err != nil {
+			return "", err
+		}
+		address, err = btcutil.NewAddressScriptHash(serializedScript, network.Params)
+	case AddressTypeP2WPKH:
+		// generate a p2wpkh address from the pubkey hash
+		address, err = btcutil.NewAddressWitnessPubKeyHash(pubKeyHash, network.Params)
+	case AddressTypeP2TR:
+		// generate a Taproot address from the BIP86 tweaked output key
+		tapKey := txscript.ComputeTaprootKeyNoScript(pubKey)
+		address, err = btcutil.NewAddressTaproot(schnorr.SerializePubKey(tapKey), network.Params)
+	}
+	if err != nil {
+		return "", err
+	}
+	return address.EncodeAddress(), nil
+}
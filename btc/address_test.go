@@ -0,0 +1,115 @@
+package btc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/tyler-smith/go-bip32"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// deriveKey derives the key at the hardened purpose and coin type and account 0 of the abandon about mnemonic,
+// followed by the change and address index
+func deriveKey(t *testing.T, purpose, coinType, change, index uint32) *btcec.PrivateKey {
+	t.Helper()
+	key, err := bip32.NewMasterKey(bip39.NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range []uint32{bip32.FirstHardenedChild + purpose, bip32.FirstHardenedChild + coinType, bip32.FirstHardenedChild, change, index} {
+		key, err = key.NewChildKey(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	prvKey, _ := btcec.PrivKeyFromBytes(key.Key)
+	return prvKey
+}
+
+// TestNewAddress checks the first addresses and WIFs of the abandon about mnemonic, the BIP49 address is on testnet
+// https://github.com/bitcoin/bips/blob/master/bip-0049.mediawiki#test-vectors
+// https://github.com/bitcoin/bips/blob/master/bip-0084.mediawiki#test-vectors
+// https://github.com/bitcoin/bips/blob/master/bip-0086.mediawiki#test-vectors
+func TestNewAddress(t *testing.T) {
+	tests := []struct {
+		purpose     uint32
+		coinType    uint32
+		network     *Network
+		addressType AddressType
+		address     string
+		wif         string
+	}{
+		{44, 0, Mainnet, AddressTypeP2PKH, "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA", "L4p2b9VAf8k5aUahF1JCJUzZkgNEAqLfq8DDdQiyAprQAKSbu8hf"},
+		{49, 1, Testnet, AddressTypeP2SHP2WPKH, "2Mww8dCYPUpKHofjgcXcBCEGmniw9CoaiD2", "cULrpoZGXiuC19Uhvykx7NugygA3k86b3hmdCeyvHYQZSxojGyXJ"},
+		{84, 0, Mainnet, AddressTypeP2WPKH, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", "KyZpNDKnfs94vbrwhJneDi77V6jF64PWPF8x5cdJb8ifgg2DUc9d"},
+		{86, 0, Mainnet, AddressTypeP2TR, "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", "KyRv5iFPHG7iB5E4CqvMzH3WFJVhbfYK4VY7XAedd9Ys69mEsPLQ"},
+	}
+	for _, test := range tests {
+		t.Run(test.addressType.String(), func(t *testing.T) {
+			prvKey := deriveKey(t, test.purpose, test.coinType, 0, 0)
+			wif, err := FromPrivateKey(prvKey, test.network, true, test.addressType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if wif.Address != test.address {
+				t.Errorf("address = %s, want %s", wif.Address, test.address)
+			}
+			if wif.WIFString != test.wif {
+				t.Errorf("wif = %s, want %s", wif.WIFString, test.wif)
+			}
+		})
+	}
+}
+
+func TestAddressTypeValidate(t *testing.T) {
+	tests := []struct {
+		addressType AddressType
+		compress    bool
+		wantErr     bool
+	}{
+		{AddressTypeP2PKH, true, false},
+		{AddressTypeP2PKH, false, false},
+		{AddressTypeP2SHP2WPKH, false, true},
+		{AddressTypeP2WPKH, false, true},
+		{AddressTypeP2TR, false, true},
+		{AddressTypeP2TR, true, false},
+		{AddressType(4), true, true},
+	}
+	for _, test := range tests {
+		if err := test.addressType.Validate(test.compress); (err != nil) != test.wantErr {
+			t.Errorf("%s.Validate(%v) error = %v, wantErr %v", test.addressType, test.compress, err, test.wantErr)
+		}
+	}
+}
+
+// TestAltcoinAddress checks the first addresses of the abandon about mnemonic on the Base58 altcoins
+func TestAltcoinAddress(t *testing.T) {
+	tests := []struct {
+		network     *Network
+		purpose     uint32
+		coinType    uint32
+		addressType AddressType
+		want        string
+	}{
+		{Litecoin, 44, 2, AddressTypeP2PKH, "LUWPbpM43E2p7ZSh8cyTBEkvpHmr3cB8Ez"},
+		{Litecoin, 49, 2, AddressTypeP2SHP2WPKH, "M7wtsL7wSHDBJVMWWhtQfTMSYYkyooAAXM"},
+		{Litecoin, 84, 2, AddressTypeP2WPKH, "ltc1qjmxnz78nmc8nq77wuxh25n2es7rzm5c2rkk4wh"},
+		{Dogecoin, 44, 3, AddressTypeP2PKH, "DBus3bamQjgJULBJtYXpEzDWQRwF5iwxgC"},
+		{Dash, 44, 5, AddressTypeP2PKH, "XoJA8qE3N2Y3jMLEtZ3vcN42qseZ8LvFf5"},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s/%d", test.network.Name, test.purpose), func(t *testing.T) {
+			wif, err := FromPrivateKey(deriveKey(t, test.purpose, test.coinType, 0, 0), test.network, true, test.addressType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if wif.Address != test.want {
+				t.Errorf("address = %s, want %s", wif.Address, test.want)
+			}
+		})
+	}
+	if _, err := FromPrivateKey(deriveKey(t, 84, 3, 0, 0), Dogecoin, true, AddressTypeP2WPKH); err == nil {
+		t.Error("FromPrivateKey(Dogecoin, P2WPKH) error = nil, want unsupported")
+	}
+}
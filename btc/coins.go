@@ -0,0 +1,60 @@
+// Package btc
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package btc
+
+import (
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// Version bytes of the Base58 chains derived from Bitcoin
+// https://github.com/trezor/trezor-firmware/tree/main/common/defs/bitcoin
+var (
+	Litecoin = newAltcoin("litecoin", 0x30, 0x32, 0xb0, "ltc",
+		[]byte{0x01, 0x9d, 0x9c, 0xfe}, []byte{0x01, 0x9d, 0xa4, 0x62}, []byte{0x01, 0xb2, 0x6e, 0xf6}, Mainnet.PublicVersionNative)
+	Dogecoin = newAltcoin("dogecoin", 0x1e, 0x16, 0x9e, "",
+		[]byte{0x02, 0xfa, 0xc3, 0x98}, []byte{0x02, 0xfa, 0xca, 0xfd}, nil, nil)
+	Dash = newAltcoin("dash", 0x4c, 0x10, 0xcc, "",
+		Mainnet.PrivateVersion(), Mainnet.PublicVersion, nil, nil)
+	DigiByte = newAltcoin("digibyte", 0x1e, 0x3f, 0x80, "dgb",
+		Mainnet.PrivateVersion(), Mainnet.PublicVersion, Mainnet.PublicVersionNested, Mainnet.PublicVersionNative)
+	Ravencoin = newAltcoin("ravencoin", 0x3c, 0x7a, 0x80, "",
+		Mainnet.PrivateVersion(), Mainnet.PublicVersion, nil, nil)
+	Peercoin = newAltcoin("peercoin", 0x37, 0x75, 0xb7, "",
+		Mainnet.PrivateVersion(), Mainnet.PublicVersion, nil, nil)
+	Viacoin = newAltcoin("viacoin", 0x47, 0x21, 0xc7, "via",
+		Mainnet.PrivateVersion(), Mainnet.PublicVersion, Mainnet.PublicVersionNested, Mainnet.PublicVersionNative)
+	Monacoin = newAltcoin("monacoin", 0x32, 0x37, 0xb0, "mona",
+		Mainnet.PrivateVersion(), Mainnet.PublicVersion, Mainnet.PublicVersionNested, Mainnet.PublicVersionNative)
+	Qtum = newAltcoin("qtum", 0x3a, 0x32, 0x80, "qc",
+		Mainnet.PrivateVersion(), Mainnet.PublicVersion, Mainnet.PublicVersionNested, Mainnet.PublicVersionNative)
+	BitcoinGold = newAltcoin("bitcoingold", 0x26, 0x17, 0x80, "btg",
+		Mainnet.PrivateVersion(), Mainnet.PublicVersion, Mainnet.PublicVersionNested, Mainnet.PublicVersionNative)
+)
+
+// newAltcoin returns the network of a Bitcoin derived chain
+// Chains without a bech32 prefix only support P2PKH, the others add the BIP49 and BIP84 SegWit types
+func newAltcoin(name string, pubKeyHashAddrID, scriptHashAddrID, privateKeyID byte, bech32HRP string, privateVersion, publicVersion, publicVersionNested, publicVersionNative []byte) *Network {
+	params := chaincfg.MainNetParams
+	params.Name = name
+	params.PubKeyHashAddrID = pubKeyHashAddrID
+	params.ScriptHashAddrID = scriptHashAddrID
+	params.PrivateKeyID = privateKeyID
+	params.Bech32HRPSegwit = bech32HRP
+	copy(params.HDPrivateKeyID[:], privateVersion)
+	copy(params.HDPublicKeyID[:], publicVersion)
+
+	addressTypes := []AddressType{AddressTypeP2PKH}
+	if bech32HRP != "" {
+		addressTypes = append(addressTypes, AddressTypeP2SHP2WPKH, AddressTypeP2WPKH)
+	}
+	return &Network{
+		Name:                name,
+		Params:              &params,
+		AddressTypes:        addressTypes,
+		PublicVersion:       publicVersion,
+		PublicVersionNested: publicVersionNested,
+		PublicVersionNative: publicVersionNative,
+	}
+}
@@ -0,0 +1,76 @@
+// Package btc
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package btc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BIP380 : Output Script Descriptors General Operation
+// https://github.com/bitcoin/bips/blob/master/bip-0380.mediawiki#checksum
+const (
+	descriptorInputCharset    = "0123456789()[],'/*abcdefgh@:$%{}IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+	descriptorChecksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+var descriptorGenerator = [5]uint64{0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a, 0x644d626ffd}
+
+func descriptorPolymod(symbols []uint64) uint64 {
+	chk := uint64(1)
+	for _, value := range symbols {
+		top := chk >> 35
+		chk = (chk&0x7ffffffff)<<5 ^ value
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= descriptorGenerator[i]
+			}
+		}
+	}
+	return chk
+}
+
+// DescriptorChecksum returns the 8 character checksum of a descriptor without its #checksum suffix
+func DescriptorChecksum(descriptor string) (string, error) {
+	symbols := make([]uint64, 0, len(descriptor)*2)
+	groups := make([]uint64, 0, 3)
+	for _, c := range descriptor {
+		v := strings.IndexRune(descriptorInputCharset, c)
+		if v < 0 {
+			return "", fmt.Errorf("invalid descriptor character %q", c)
+		}
+		symbols = append(symbols, uint64(v&31))
+		groups = append(groups, uint64(v>>5))
+		if len(groups) == 3 {
+			symbols = append(symbols, groups[0]*9+groups[1]*3+groups[2])
+			groups = groups[:0]
+		}
+	}
+	switch len(groups) {
+	case 1:
+		symbols = append(symbols, groups[0])
+	case 2:
+		symbols = append(symbols, groups[0]*3+groups[1])
+	}
+	symbols = append(symbols, 0, 0, 0, 0, 0, 0, 0, 0)
+
+	checksum := descriptorPolymod(symbols) ^ 1
+	var sb strings.Builder
+	for i := 0; i < 8; i++ {
+		sb.WriteByte(descriptorChecksumCharset[(checksum>>(5*(7-i)))&31])
+	}
+	return sb.String(), nil
+}
+
+// Descriptor wraps a key expression in the script expression of the address type and appends the checksum
+// e.g. Descriptor("wpkh(%s)", "[73c5da0a/84'/0'/0']xpub.../0/*") returns wpkh([73c5da0a/84'/0'/0']xpub.../0/*)#checksum
+func Descriptor(script string, key string) (string, error) {
+	descriptor := fmt.Sprintf(script, key)
+	checksum, err := DescriptorChecksum(descriptor)
+	if err != nil {
+		return "", err
+	}
+	return descriptor + "#" + checksum, nil
+}
@@ -0,0 +1,51 @@
+package btc
+
+import (
+	"testing"
+)
+
+// TestDescriptorChecksum checks the checksums of the BIP380 test vectors and the Bitcoin Core descriptor documentation
+// https://github.com/bitcoin/bips/blob/master/bip-0380.mediawiki#test-vectors
+// https://github.com/bitcoin/bitcoin/blob/master/doc/descriptors.md
+func TestDescriptorChecksum(t *testing.T) {
+	tests := []struct {
+		descriptor string
+		checksum   string
+	}{
+		{"raw(deadbeef)", "89f8spxm"},
+		{"addr(mkmZxiEcEd8ZqjQWVZuC6so5dFMKEFpN2j)", "02wpgw69"},
+		{"sh(wpkh(03fff97bd5755eeea420453a14355235d382f6472f8568a18b2f057a1460297556))", "qkrrc7je"},
+		{"pkh([d34db33f/44'/0'/0']xpub6ERApfZwUNrhLCkDtcHTcxd75RbzS1ed54G1LkBUHQVHQKqhMkhgbmJbZRkrgZw4koxb5JaHWkY4ALHY2grBGRjaDMzQLcgJvLJuZZvRcEL/1/*)", "ml40v0wf"},
+		{"wpkh([d34db33f/84h/0h/0h]xpub6DJ2dNUysrn5Vt36jH2KLBT2i1auw1tTSSomg8PhqNiUtx8QX2SvC9nrHu81fT41fvDUnhMjEzQgXnQjKEu3oaqMSzhSrHMxyyoEAmUHQbY/0/*)", "cjjspncu"},
+	}
+	for _, test := range tests {
+		t.Run(test.checksum, func(t *testing.T) {
+			checksum, err := DescriptorChecksum(test.descriptor)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if checksum != test.checksum {
+				t.Errorf("checksum = %s, want %s", checksum, test.checksum)
+			}
+		})
+	}
+}
+
+// TestDescriptorChecksumInvalidCharacter checks that characters outside the BIP380 input charset are rejected
+func TestDescriptorChecksumInvalidCharacter(t *testing.T) {
+	if _, err := DescriptorChecksum("raw(deadbeef)\n"); err == nil {
+		t.Error("expected an error for a newline")
+	}
+}
+
+// TestDescriptor checks that the script expression wraps the key and the checksum is appended
+func TestDescriptor(t *testing.T) {
+	descriptor, err := Descriptor(AddressTypeP2SHP2WPKH.DescriptorScript(), "03fff97bd5755eeea420453a14355235d382f6472f8568a18b2f057a1460297556")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "sh(wpkh(03fff97bd5755eeea420453a14355235d382f6472f8568a18b2f057a1460297556))#qkrrc7je"
+	if descriptor != want {
+		t.Errorf("descriptor = %s, want %s", descriptor, want)
+	}
+}
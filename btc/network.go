@@ -0,0 +1,156 @@
+// Package btc
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package btc
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/tyler-smith/go-bip32"
+)
+
+// Network holds the address, WIF and extended key encodings of a chain
+// It is the bip44.Chain of Bitcoin and of the Base58 chains derived from it
+type Network struct {
+	Name         string
+	Params       *chaincfg.Params
+	AddressTypes []AddressType // address types the chain can spend
+
+	// SLIP-0132 : Registered HD version bytes for BIP-0032
+	// https://github.com/satoshilabs/slips/blob/master/slip-0132.md
+	// The P2PKH versions are also used for P2TR, which has no registered version
+	PublicVersion       []byte // xpub, tpub
+	PublicVersionNested []byte // ypub, upub
+	PublicVersionNative []byte // zpub, vpub
+}
+
+var (
+	Mainnet = &Network{
+		Name:                "mainnet",
+		Params:              &chaincfg.MainNetParams,
+		AddressTypes:        AddressTypes,
+		PublicVersion:       []byte{0x04, 0x88, 0xb2, 0x1e},
+		PublicVersionNested: []byte{0x04, 0x9d, 0x7c, 0xb2},
+		PublicVersionNative: []byte{0x04, 0xb2, 0x47, 0x46},
+	}
+	Testnet = &Network{
+		Name:                "testnet",
+		Params:              &chaincfg.TestNet3Params,
+		AddressTypes:        AddressTypes,
+		PublicVersion:       []byte{0x04, 0x35, 0x87, 0xcf},
+		PublicVersionNested: []byte{0x04, 0x4a, 0x52, 0x62},
+		PublicVersionNative: []byte{0x04, 0x5f, 0x1c, 0xf6},
+	}
+	Signet = &Network{
+		Name:                "signet",
+		Params:              &chaincfg.SigNetParams,
+		AddressTypes:        AddressTypes,
+		PublicVersion:       Testnet.PublicVersion,
+		PublicVersionNested: Testnet.PublicVersionNested,
+		PublicVersionNative: Testnet.PublicVersionNative,
+	}
+	Regtest = &Network{
+		Name:                "regtest",
+		Params:              &chaincfg.RegressionNetParams,
+		AddressTypes:        AddressTypes,
+		PublicVersion:       Testnet.PublicVersion,
+		PublicVersionNested: Testnet.PublicVersionNested,
+		PublicVersionNative: Testnet.PublicVersionNative,
+	}
+)
+
+// Networks lists every supported Bitcoin network
+var Networks = []*Network{Mainnet, Testnet, Signet, Regtest}
+
+// NetworkOfVersion returns the network of extended public keys serialized with the version bytes
+// Test networks share their version bytes, so Testnet is returned for signet and regtest keys
+func NetworkOfVersion(version []byte) (*Network, bool) {
+	for _, network := range Networks {
+		if _, ok := network.AddressTypeOfVersion(version); ok {
+			return network, true
+		}
+	}
+	return nil, false
+}
+
+// ParseNetwork returns the network with the given name
+func ParseNetwork(name string) (*Network, error) {
+	for _, network := range Networks {
+		if network.Name == name {
+			return network, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid network %q, expected mainnet, testnet, signet or regtest", name)
+}
+
+// Supports reports whether the chain can spend the address type
+func (n *Network) Supports(addressType AddressType) bool {
+	for _, t := range n.AddressTypes {
+		if t == addressType {
+			return true
+		}
+	}
+	return false
+}
+
+// Label returns the label of the address type, noting whether a legacy key is compressed
+func (n *Network) Label(addressType AddressType, compress bool) string {
+	return addressType.Label(compress)
+}
+
+// Address returns the address of the given type paying to the public key
+func (n *Network) Address(pubKey *btcec.PublicKey, compress bool, addressType AddressType) (string, error) {
+	return NewAddress(pubKey, n, compress, addressType)
+}
+
+// PrivateKey returns the private key in wallet import format
+func (n *Network) PrivateKey(prvKey *btcec.PrivateKey, compress bool) (string, error) {
+	wif, err := btcutil.NewWIF(prvKey, n.Params, compress)
+	if err != nil {
+		return "", err
+	}
+	return wif.String(), nil
+}
+
+// ExtendedPublicKey returns the neutered key serialized with the SLIP-0132 version bytes of the address type
+func (n *Network) ExtendedPublicKey(key *bip32.Key, addressType AddressType) string {
+	pub := key.PublicKey()
+	pub.Version = n.PublicVersionOf(addressType)
+	return pub.B58Serialize()
+}
+
+// PrivateVersion returns the version bytes of extended private keys, xprv or tprv
+func (n *Network) PrivateVersion() []byte {
+	return n.Params.HDPrivateKeyID[:]
+}
+
+// PublicVersionOf returns the SLIP-0132 version bytes of extended public keys for the address type
+func (n *Network) PublicVersionOf(addressType AddressType) []byte {
+	switch addressType {
+	case AddressTypeP2SHP2WPKH:
+		return n.PublicVersionNested
+	case AddressTypeP2WPKH:
+		return n.PublicVersionNative
+	default:
+		return n.PublicVersion
+	}
+}
+
+// AddressTypeOfVersion returns the address type of extended public keys serialized with the version bytes
+// The P2PKH and P2TR versions are shared, so AddressTypeP2PKH is returned for both
+func (n *Network) AddressTypeOfVersion(version []byte) (AddressType, bool) {
+	switch {
+	case bytes.Equal(version, n.PublicVersion):
+		return AddressTypeP2PKH, true
+	case bytes.Equal(version, n.PublicVersionNested):
+		return AddressTypeP2SHP2WPKH, true
+	case bytes.Equal(version, n.PublicVersionNative):
+		return AddressTypeP2WPKH, true
+	}
+	return 0, false
+}
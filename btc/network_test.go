@@ -0,0 +1,104 @@
+package btc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseNetwork(t *testing.T) {
+	tests := []struct {
+		name    string
+		network *Network
+		wantErr bool
+	}{
+		{"mainnet", Mainnet, false},
+		{"testnet", Testnet, false},
+		{"signet", Signet, false},
+		{"regtest", Regtest, false},
+		{"testnet4", nil, true},
+		{"", nil, true},
+	}
+	for _, test := range tests {
+		network, err := ParseNetwork(test.name)
+		if (err != nil) != test.wantErr {
+			t.Errorf("ParseNetwork(%q) error = %v, wantErr %v", test.name, err, test.wantErr)
+		}
+		if network != test.network {
+			t.Errorf("ParseNetwork(%q) = %v, want %v", test.name, network, test.network)
+		}
+	}
+}
+
+func TestNetworkOfVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     []byte
+		network     *Network
+		addressType AddressType
+	}{
+		{"xpub", Mainnet.PublicVersion, Mainnet, AddressTypeP2PKH},
+		{"ypub", Mainnet.PublicVersionNested, Mainnet, AddressTypeP2SHP2WPKH},
+		{"zpub", Mainnet.PublicVersionNative, Mainnet, AddressTypeP2WPKH},
+		{"tpub", Signet.PublicVersion, Testnet, AddressTypeP2PKH},
+		{"upub", Regtest.PublicVersionNested, Testnet, AddressTypeP2SHP2WPKH},
+		{"vpub", Testnet.PublicVersionNative, Testnet, AddressTypeP2WPKH},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			network, ok := NetworkOfVersion(test.version)
+			if !ok || network != test.network {
+				t.Fatalf("NetworkOfVersion() = %v, %v, want %v", network, ok, test.network)
+			}
+			if addressType, _ := network.AddressTypeOfVersion(test.version); addressType != test.addressType {
+				t.Errorf("AddressTypeOfVersion() = %s, want %s", addressType, test.addressType)
+			}
+		})
+	}
+	if _, ok := NetworkOfVersion([]byte{0x04, 0x88, 0xad, 0xe4}); ok {
+		t.Error("NetworkOfVersion accepted the xprv version")
+	}
+}
+
+// TestNetworkAddress checks that the test networks encode the same m/84'/1'/0'/0/0 key with their own prefixes
+// The testnet address is the widely published m/84'/1'/0'/0/0 address of the abandon about mnemonic
+func TestNetworkAddress(t *testing.T) {
+	prvKey := deriveKey(t, 84, 1, 0, 0)
+	wif, err := FromPrivateKey(prvKey, Testnet, true, AddressTypeP2WPKH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "tb1q6rz28mcfaxtmd6v789l9rrlrusdprr9pqcpvkl"; wif.Address != want {
+		t.Errorf("testnet address = %s, want %s", wif.Address, want)
+	}
+
+	tests := []struct {
+		network *Network
+		prefix  string
+		wif     string
+	}{
+		{Testnet, "tb1q", "c"},
+		{Signet, "tb1q", "c"},
+		{Regtest, "bcrt1q", "c"},
+	}
+	want := ""
+	for _, test := range tests {
+		t.Run(test.network.Name, func(t *testing.T) {
+			wif, err := FromPrivateKey(prvKey, test.network, true, AddressTypeP2WPKH)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(wif.Address, test.prefix) || !strings.HasPrefix(wif.WIFString, test.wif) {
+				t.Fatalf("address = %s, wif = %s, want %s... and %s...", wif.Address, wif.WIFString, test.prefix, test.wif)
+			}
+			// the witness program is the same on every network, only the prefix and checksum differ
+			program := strings.TrimPrefix(wif.Address, test.prefix)
+			program = program[:len(program)-6]
+			if want == "" {
+				want = program
+			}
+			if program != want {
+				t.Errorf("witness program = %s, want %s", program, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Package cardano
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package cardano
+
+import (
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"golang.org/x/crypto/blake2b"
+)
+
+// CIP-0019 : Cardano Addresses, the header nibble is the address type and the low nibble the network id
+// https://github.com/cardano-foundation/CIPs/blob/master/CIP-0019/README.md
+const (
+	headerBase   byte = 0x00 // payment key hash, stake key hash
+	headerReward byte = 0xe0 // stake key hash
+)
+
+// Network holds the network id and the CIP-0005 bech32 prefixes of a Cardano network
+// key-gen only derives mainnet accounts, --network selects the test networks of the Base58 chains
+type Network struct {
+	Name       string
+	NetworkID  byte
+	AddressHRP string
+	StakeHRP   string
+}
+
+var Mainnet = &Network{"mainnet", 1, "addr", "stake"}
+
+// KeyHash returns the BLAKE2b-224 hash of a public key
+func KeyHash(pubKey []byte) []byte {
+	h, _ := blake2b.New(28, nil)
+	h.Write(pubKey)
+	return h.Sum(nil)
+}
+
+// BaseAddress returns the base address paying to the payment key and delegating to the stake key, addr1... on mainnet
+func (n *Network) BaseAddress(paymentKey, stakeKey []byte) (string, error) {
+	payload := append([]byte{headerBase | n.NetworkID}, KeyHash(paymentKey)...)
+	payload = append(payload, KeyHash(stakeKey)...)
+	return bech32.EncodeFromBase256(n.AddressHRP, payload)
+}
+
+// StakeAddress returns the reward address of the stake key, stake1... on mainnet
+func (n *Network) StakeAddress(stakeKey []byte) (string, error) {
+	return bech32.EncodeFromBase256(n.StakeHRP, append([]byte{headerReward | n.NetworkID}, KeyHash(stakeKey)...))
+}
+
+// AccountXVK returns the account extended verification key, the public key followed by the chain code, acct_xvk1...
+func AccountXVK(key *Key) (string, error) {
+	return bech32.EncodeFromBase256("acct_xvk", append(key.PublicKey(), key.ChainCode...))
+}
+
+// SigningKey returns the extended signing key, kL || kR || chain code, with the given CIP-0005 prefix such as addr_xsk
+func SigningKey(hrp string, key *Key) (string, error) {
+	return bech32.EncodeFromBase256(hrp, append(append([]byte{}, key.Key...), key.ChainCode...))
+}
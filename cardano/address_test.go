@@ -0,0 +1,67 @@
+package cardano
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+// cip1852Key derives the key at m/1852'/1815'/account'/role/index from the Icarus master key of the mnemonic
+func cip1852Key(t *testing.T, mnemonic string, account, role, index uint32) *Key {
+	t.Helper()
+	entropy, err := bip39.EntropyFromMnemonic(mnemonic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := NewMasterKey(entropy, "")
+	for _, i := range []uint32{FirstHardenedIndex + 1852, FirstHardenedIndex + 1815, FirstHardenedIndex + account, role, index} {
+		key = key.NewChildKey(i)
+	}
+	return key
+}
+
+// TestKeyHash checks the payment key hash of the CIP-0019 test vectors, derived at m/1852'/1815'/0'/0/0
+// https://github.com/cardano-foundation/CIPs/blob/master/CIP-0019/README.md#test-vectors
+func TestKeyHash(t *testing.T) {
+	paymentKey := cip1852Key(t, "test walk nut penalty hip pave soap entry language right filter choice", 0, 0, 0).PublicKey()
+	got := hex.EncodeToString(KeyHash(paymentKey))
+	want := "9493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e"
+	if got != want {
+		t.Errorf("payment key hash = %s, want %s", got, want)
+	}
+}
+
+// TestAddresses checks the first base address Cardano wallets show for the abandon about mnemonic
+// and the reward address of the stake key hash it carries
+func TestAddresses(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		network  *Network
+		base     string
+		stake    string
+	}{
+		{"abandon about", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", Mainnet, "addr1qy8ac7qqy0vtulyl7wntmsxc6wex80gvcyjy33qffrhm7sh927ysx5sftuw0dlft05dz3c7revpf7jx0xnlcjz3g69mq4afdhv", "stake1u8j40zgr2gy4788kl54h6x3gu0pukq5lfr8nflufpg5dzaskqlx2l"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			paymentKey := cip1852Key(t, test.mnemonic, 0, 0, 0).PublicKey()
+			stakeKey := cip1852Key(t, test.mnemonic, 0, 2, 0).PublicKey()
+			base, err := test.network.BaseAddress(paymentKey, stakeKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if base != test.base {
+				t.Errorf("base address = %s, want %s", base, test.base)
+			}
+			stake, err := test.network.StakeAddress(stakeKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stake != test.stake {
+				t.Errorf("stake address = %s, want %s", stake, test.stake)
+			}
+		})
+	}
+}
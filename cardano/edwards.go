@@ -0,0 +1,44 @@
+// Package cardano
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package cardano
+
+import (
+	"filippo.io/edwards25519"
+)
+
+// scalarBaseMult returns the 32-byte encoding of kL·B for the little-endian scalar kL
+// BIP32-Ed25519 uses kL as it is, without the SHA-512 expansion crypto/ed25519 applies to seeds
+// Child scalars are not clamped, so kL is reduced mod l instead of being set with clamping, which gives the same point
+func scalarBaseMult(scalar []byte) []byte {
+	wide := make([]byte, 64)
+	copy(wide, scalar)
+	// SetUniformBytes only fails on inputs that are not 64 bytes long
+	s, _ := edwards25519.NewScalar().SetUniformBytes(wide)
+	return new(edwards25519.Point).ScalarBaseMult(s).Bytes()
+}
+
+// addLittleEndian returns a + b mod 2^256 for 32-byte little-endian numbers
+func addLittleEndian(a, b []byte) []byte {
+	sum := make([]byte, 32)
+	var carry uint16
+	for i := range sum {
+		carry += uint16(a[i]) + uint16(b[i])
+		sum[i] = byte(carry)
+		carry >>= 8
+	}
+	return sum
+}
+
+// mul8 returns 8·z as a 32-byte little-endian number for the 28-byte little-endian zL
+func mul8(z []byte) []byte {
+	product := make([]byte, 32)
+	var carry byte
+	for i := 0; i < 28; i++ {
+		product[i] = z[i]<<3 | carry
+		carry = z[i] >> 5
+	}
+	product[28] = carry
+	return product
+}
@@ -0,0 +1,71 @@
+// Package cardano
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package cardano
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// Icarus master key generation and BIP32-Ed25519 child derivation, as used by Cardano Shelley wallets
+// https://github.com/cardano-foundation/CIPs/blob/master/CIP-0003/Icarus.md
+// https://input-output-hk.github.io/adrestia/static/Ed25519_BIP.pdf
+
+const FirstHardenedIndex uint32 = 0x80000000 // 0'
+
+// Key is a BIP32-Ed25519 extended private key, the 64-byte kL || kR and its chain code
+type Key struct {
+	Key       []byte
+	ChainCode []byte
+}
+
+// NewMasterKey returns the Icarus master key of BIP39 entropy, PBKDF2-HMAC-SHA512(passphrase, entropy, 4096) clamped to an ed25519 scalar
+// The key is derived from the mnemonic entropy, not from the BIP39 seed
+func NewMasterKey(entropy []byte, passphrase string) *Key {
+	data := pbkdf2.Key([]byte(passphrase), entropy, 4096, 96, sha512.New)
+	data[0] &= 0xf8
+	data[31] &= 0x1f
+	data[31] |= 0x40
+	return &Key{Key: data[:64], ChainCode: data[64:]}
+}
+
+// PublicKey returns the 32-byte ed25519 public key kL·B
+func (k *Key) PublicKey() []byte {
+	return scalarBaseMult(k.Key[:32])
+}
+
+// NewChildKey returns the child at index, hardened children hash the private key and soft children the public key
+func (k *Key) NewChildKey(index uint32) *Key {
+	var zTag, cTag byte
+	data := make([]byte, 0, 69)
+	if index >= FirstHardenedIndex {
+		zTag, cTag = 0x00, 0x01
+		data = append(data, k.Key...)
+	} else {
+		zTag, cTag = 0x02, 0x03
+		data = append(data, k.PublicKey()...)
+	}
+	data = binary.LittleEndian.AppendUint32(data, index)
+
+	z := hmacSHA512(k.ChainCode, zTag, data)
+	c := hmacSHA512(k.ChainCode, cTag, data)
+
+	// kL' = kL + 8·zL, with zL the first 28 bytes of Z, and kR' = kR + zR, both mod 2^256
+	kL := addLittleEndian(k.Key[:32], mul8(z[:28]))
+	kR := addLittleEndian(k.Key[32:], z[32:64])
+
+	key := append(kL, kR...)
+	return &Key{Key: key, ChainCode: c[32:]}
+}
+
+func hmacSHA512(key []byte, tag byte, data []byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	mac.Write([]byte{tag})
+	mac.Write(data)
+	return mac.Sum(nil)
+}
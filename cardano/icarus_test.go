@@ -0,0 +1,34 @@
+package cardano
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+// TestNewMasterKey checks the Icarus master keys of the CIP-0003 test vectors
+// https://github.com/cardano-foundation/CIPs/blob/master/CIP-0003/Icarus.md
+func TestNewMasterKey(t *testing.T) {
+	tests := []struct {
+		mnemonic   string
+		passphrase string
+		masterKey  string
+	}{
+		{"eight country switch draw meat scout mystery blade tip drift useless good keep usage title", "", "c065afd2832cd8b087c4d9ab7011f481ee1e0721e78ea5dd609f3ab3f156d245d176bd8fd4ec60b4731c3918a2a72a0226c0cd119ec35b47e4d55884667f552a23f7fdcd4a10c6cd2c7393ac61d877873e248f417634aa3d812af327ffe9d620"},
+		{"eight country switch draw meat scout mystery blade tip drift useless good keep usage title", "foo", "70531039904019351e1afb361cd1b312a4d0565d4ff9f8062d38acf4b15cce41d7b5738d9c893feea55512a3004acb0d222c35d3e3d5cde943a15a9824cbac59443cf67e589614076ba01e354b1a432e0e6db3b59e37fc56b5fb0222970a010e"},
+	}
+	for _, test := range tests {
+		t.Run(test.passphrase, func(t *testing.T) {
+			entropy, err := bip39.EntropyFromMnemonic(test.mnemonic)
+			if err != nil {
+				t.Fatal(err)
+			}
+			key := NewMasterKey(entropy, test.passphrase)
+			got := hex.EncodeToString(append(append([]byte{}, key.Key...), key.ChainCode...))
+			if got != test.masterKey {
+				t.Errorf("master key = %s, want %s", got, test.masterKey)
+			}
+		})
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 	"github.com/tyler-smith/go-bip39"
 
 	"key-gen/bip44"
@@ -33,7 +32,11 @@ It accepts or generates the base mnemonic and can encrypt the mnemonic with a pa
 		}
 
 		mnemonic := config.KeyConfig.Mnemonic
-		if mnemonic == "" {
+		if mnemonic != "" {
+			if config.KeyConfig.ExportOptions().HardenedAccountChanged() {
+				warnHardenedAccountMigration()
+			}
+		} else {
 			// Generate a mnemonic for memorization or user-friendly seeds
 			entropy, err := bip39.NewEntropy(256)
 			if err != nil {
@@ -74,7 +77,7 @@ It accepts or generates the base mnemonic and can encrypt the mnemonic with a pa
 
 		if !config.KeyConfig.GlobalConfig.SuppressOutput {
 			fmt.Printf("\n%-18s \n", config.KeyConfig.Name)
-			out, err := km.ToPrettyString(config.KeyConfig.Accounts, config.KeyConfig.Compressed)
+			out, err := km.ToPrettyString(config.KeyConfig.ExportOptions())
 			if err != nil {
 				_, _ = fmt.Fprintf(os.Stderr, "Failed outputting key with error: %v\n", err)
 				return
@@ -90,22 +93,20 @@ func init() {
 
 	createCmd.PersistentFlags().StringP("mnemonic", "m", "", "Base mnemonic for the wallet (optional)")
 	createCmd.PersistentFlags().IntP("accounts", "a", util.DefaultAccounts, "Number of accounts to generate")
+	createCmd.PersistentFlags().Uint32P("account", "", 0, "Account level of the derivation path")
+	createCmd.PersistentFlags().StringP("change", "", util.DefaultChange, "Change level of the derivation path: external, internal or both")
+	createCmd.PersistentFlags().Uint32P("start-index", "", 0, "First address index to generate")
+	createCmd.PersistentFlags().StringSliceP("purposes", "", util.DefaultPurposes, "Bitcoin purposes to generate: 44 (P2PKH), 49 (P2SH-P2WPKH), 84 (P2WPKH) and/or 86 (P2TR)")
+	createCmd.PersistentFlags().StringSliceP("coin", "", util.DefaultCoins, "Coins to generate: btc, ltc, doge, dash, dgb, rvn, ppc, via, mona, qtum, btg, bch, zec, dcr, grs, trx, xrp, sol, ada, xlm, apt, sui, fil, xtz, avax and/or kas")
+	createCmd.PersistentFlags().StringSliceP("evm-chains", "", util.DefaultEVMChains, "EVM chains to generate: eth, etc, rsk, polygon, bsc, arbitrum, optimism, base, avalanche, gnosis and/or fantom")
+	createCmd.PersistentFlags().StringSliceP("cosmos-chains", "", nil, "Cosmos SDK chains to generate: cosmos, osmosis, celestia, dydx, akash, juno, stargaze, noble, sei, terra, kava, secret and/or hrp:coin_type")
+	createCmd.PersistentFlags().Uint32P("xrp-tag", "", 0, "Destination tag to encode in the XRP X-addresses, none when unset")
+	createCmd.PersistentFlags().StringP("network", "", util.DefaultNetwork, "Network: mainnet, testnet, signet or regtest, signet and regtest are Bitcoin only")
 	createCmd.PersistentFlags().StringP("name", "n", util.DefaultName, "Name of the wallet")
 	createCmd.PersistentFlags().BoolP("encrypt-mnemonic", "e", false, "Encrypt the mnemonic with a password")
 	createCmd.PersistentFlags().BoolP("compressed", "c", true, "Compress the output keys")
 	createCmd.PersistentFlags().BoolP("save", "", true, "Save the wallet to a file or to 1Password")
 
-	viper.SetEnvPrefix("op")
 	createCmd.Flags().StringP("op-service-account-token", "t", "", "1Password service account token (optional)")
 	createCmd.Flags().StringP("op-vault-id", "v", "", "1Password vault ID (optional)")
-	err := viper.BindPFlag("service_account_token", createCmd.Flags().Lookup("op-service-account-token"))
-	if err != nil {
-		fmt.Println(err)
-		return
-	}
-	err = viper.BindPFlag("vault_id", createCmd.Flags().Lookup("op-vault-id"))
-	if err != nil {
-		fmt.Println(err)
-		return
-	}
 }
@@ -0,0 +1,73 @@
+// Package cmd
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"key-gen/bip44"
+	"key-gen/util"
+)
+
+// deriveCmd represents the derive command
+var deriveCmd = &cobra.Command{
+	Use:   "derive",
+	Short: "Derive the key at an arbitrary BIP32 path",
+	Long: `Derive the key at an arbitrary BIP-0032 path from an existing mnemonic and print its address formats. 
+Hardened levels can be marked with ', h or H, for example m/0'/0'/5' or m/44h/501h/0h/0h.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		config, err := util.NewDeriveConfig(cmd.Flags())
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed parsing derive flags with error: %v\n", err)
+			return
+		}
+
+		password := config.GlobalConfig.Password
+		if !config.EncryptMnemonic {
+			password = ""
+		}
+
+		indices, err := bip44.ParsePath(config.Path)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed parsing path with error: %v\n", err)
+			return
+		}
+		if bip44.HardenedAccountChanged(indices) {
+			warnHardenedAccountMigration()
+		}
+
+		km, err := bip44.NewKeyManager(config.Mnemonic, password)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed creating BIP44 key with error: %v\n", err)
+			return
+		}
+
+		key, err := km.DeriveKeyByPath(config.Path)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed deriving key with error: %v\n", err)
+			return
+		}
+
+		out, err := key.ToPrettyString(config.Network, config.Compressed, config.GlobalConfig.SuppressOutput)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed outputting key with error: %v\n", err)
+			return
+		}
+		fmt.Print(out)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(deriveCmd)
+
+	deriveCmd.PersistentFlags().StringP("mnemonic", "m", "", "Base mnemonic for the wallet")
+	deriveCmd.PersistentFlags().StringP("path", "", "", "BIP32 derivation path, e.g. m/44'/0'/0'/0/0")
+	deriveCmd.PersistentFlags().StringP("network", "", util.DefaultNetwork, "Bitcoin network: mainnet, testnet, signet or regtest")
+	deriveCmd.PersistentFlags().BoolP("encrypt-mnemonic", "e", false, "The mnemonic was encrypted with the password")
+	deriveCmd.PersistentFlags().BoolP("compressed", "c", true, "Compress the output keys")
+}
@@ -31,7 +31,11 @@ It accepts or generates the base mnemonic and can encrypt the mnemonic with a pa
 			os.Exit(1)
 		}
 		mnemonic := config.Mnemonic
-		if mnemonic == "" {
+		if mnemonic != "" {
+			if config.ExportOptions().HardenedAccountChanged() {
+				warnHardenedAccountMigration()
+			}
+		} else {
 			// Generate a mnemonic for memorization or user-friendly seeds
 			entropy, err := bip39.NewEntropy(256)
 			if err != nil {
@@ -75,6 +79,15 @@ func init() {
 
 	encryptCmd.PersistentFlags().StringP("mnemonic", "m", "", "Base mnemonic for the wallet (optional)")
 	encryptCmd.PersistentFlags().IntP("accounts", "a", util.DefaultAccounts, "Number of accounts to generate")
+	encryptCmd.PersistentFlags().Uint32P("account", "", 0, "Account level of the derivation path")
+	encryptCmd.PersistentFlags().StringP("change", "", util.DefaultChange, "Change level of the derivation path: external, internal or both")
+	encryptCmd.PersistentFlags().Uint32P("start-index", "", 0, "First address index to generate")
+	encryptCmd.PersistentFlags().StringSliceP("purposes", "", util.DefaultPurposes, "Bitcoin purposes to generate: 44 (P2PKH), 49 (P2SH-P2WPKH), 84 (P2WPKH) and/or 86 (P2TR)")
+	encryptCmd.PersistentFlags().StringSliceP("coin", "", util.DefaultCoins, "Coins to generate: btc, ltc, doge, dash, dgb, rvn, ppc, via, mona, qtum, btg, bch, zec, dcr, grs, trx, xrp, sol, ada, xlm, apt, sui, fil, xtz, avax and/or kas")
+	encryptCmd.PersistentFlags().StringSliceP("evm-chains", "", util.DefaultEVMChains, "EVM chains to generate: eth, etc, rsk, polygon, bsc, arbitrum, optimism, base, avalanche, gnosis and/or fantom")
+	encryptCmd.PersistentFlags().StringSliceP("cosmos-chains", "", nil, "Cosmos SDK chains to generate: cosmos, osmosis, celestia, dydx, akash, juno, stargaze, noble, sei, terra, kava, secret and/or hrp:coin_type")
+	encryptCmd.PersistentFlags().Uint32P("xrp-tag", "", 0, "Destination tag to encode in the XRP X-addresses, none when unset")
+	encryptCmd.PersistentFlags().StringP("network", "", util.DefaultNetwork, "Network: mainnet, testnet, signet or regtest, signet and regtest are Bitcoin only")
 	encryptCmd.PersistentFlags().StringP("name", "n", util.DefaultName, "Name of the wallet")
 	encryptCmd.PersistentFlags().BoolP("encrypt-mnemonic", "e", false, "Encrypt the mnemonic with a password")
 	encryptCmd.PersistentFlags().BoolP("compressed", "c", true, "Compress the output keys")
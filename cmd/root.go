@@ -5,6 +5,7 @@ Copyright © 2024 Evan Owen <admin@ulmentflam.com>
 package cmd
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -37,3 +38,14 @@ func init() {
 
 	rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 }
+
+// warnHardenedAccountMigration warns users re-deriving an existing mnemonic that keys saved by
+// earlier versions of key-gen used a non-hardened account level and differ from the current keys
+// Callers only warn when the selected keys include account 0 of Bitcoin or Ethereum, the only account earlier versions derived
+func warnHardenedAccountMigration() {
+	_, _ = fmt.Fprintf(os.Stderr, `Warning: the account level is now derived hardened (m/purpose'/coin_type'/account') as BIP44 requires.
+Keys saved from this mnemonic by earlier versions of key-gen for account 0 of Bitcoin and Ethereum were derived
+from a non-hardened account level and do not match the keys below or other BIP44 wallets.
+Move any funds held by previously saved addresses using their saved private keys.
+`)
+}
@@ -0,0 +1,80 @@
+// Package cmd
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"key-gen/bip44"
+	"key-gen/save"
+	"key-gen/util"
+)
+
+// watchCmd represents the watch command
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Derive watch-only addresses from an account extended public key",
+	Long: `Derive receive and change addresses from an account xpub, ypub or zpub without the mnemonic. 
+Only the non-hardened change and address index levels can be derived, and no private keys are produced. 
+The address type follows the SLIP-0132 version of the key, use --purpose 86 for Taproot xpubs. 
+Test network keys (tpub, upub or vpub) are read as testnet, use --network signet or regtest for those networks.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		config, err := util.NewWatchConfig(cmd.Flags())
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed parsing watch flags with error: %v\n", err)
+			return
+		}
+
+		wm, err := bip44.NewWatchOnlyManager(config.ExtendedKey, config.Purpose, config.KeyConfig.Network)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed reading extended public key with error: %v\n", err)
+			return
+		}
+
+		if config.Save {
+			newSave, err := save.NewSave(*config.KeyConfig)
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "Failed creating save with error: %v\n", err)
+				return
+			}
+			err = newSave.Save(context.Background(), wm)
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "Failed saving addresses with error: %v\n", err)
+				return
+			}
+		}
+
+		if !config.KeyConfig.GlobalConfig.SuppressOutput {
+			fmt.Printf("\n%-18s \n", config.KeyConfig.Name)
+			out, err := wm.ToPrettyString(config.KeyConfig.ExportOptions())
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "Failed outputting addresses with error: %v\n", err)
+				return
+			}
+			fmt.Print(out)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+
+	watchCmd.PersistentFlags().StringP("xpub", "x", "", "Account extended public key (xpub, ypub, zpub, tpub, upub or vpub)")
+	watchCmd.PersistentFlags().Uint32P("purpose", "", 0, "Purpose of the extended public key: 44, 49, 84 or 86 (optional, read from the key version)")
+	watchCmd.PersistentFlags().StringP("network", "", "", "Bitcoin network: mainnet, testnet, signet or regtest (optional, read from the key version)")
+	watchCmd.PersistentFlags().IntP("accounts", "a", util.DefaultAccounts, "Number of addresses to generate")
+	watchCmd.PersistentFlags().StringP("change", "", util.DefaultChange, "Change level of the derivation path: external, internal or both")
+	watchCmd.PersistentFlags().Uint32P("start-index", "", 0, "First address index to generate")
+	watchCmd.PersistentFlags().StringP("name", "n", util.DefaultName, "Name of the wallet")
+	watchCmd.PersistentFlags().BoolP("compressed", "c", true, "Compress the output keys")
+	watchCmd.PersistentFlags().BoolP("save", "", false, "Save the addresses to a file or to 1Password")
+
+	watchCmd.Flags().StringP("op-service-account-token", "t", "", "1Password service account token (optional)")
+	watchCmd.Flags().StringP("op-vault-id", "v", "", "1Password vault ID (optional)")
+}
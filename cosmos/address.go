@@ -0,0 +1,32 @@
+// Package cosmos
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package cosmos
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+)
+
+// Cosmos SDK accounts are the RIPEMD160(SHA256(compressed public key)) of a secp256k1 key, bech32 encoded with the chain's HRP
+// https://docs.cosmos.network/main/learn/beginner/accounts#addresses
+
+// EncodeAddress returns the bech32 account address of the public key, e.g. cosmos1...
+func EncodeAddress(hrp string, pubKey *btcec.PublicKey) (string, error) {
+	return bech32.EncodeFromBase256(hrp, btcutil.Hash160(pubKey.SerializeCompressed()))
+}
+
+// HexPrivateKey returns the private key as hex, the format imported by <daemon> keys import-hex
+func HexPrivateKey(prvKey *btcec.PrivateKey) string {
+	return fmt.Sprintf("%x", prvKey.Serialize())
+}
+
+// Base64PrivateKey returns the private key as base64, the value of a secp256k1 priv_key in the keyring's amino JSON
+func Base64PrivateKey(prvKey *btcec.PrivateKey) string {
+	return base64.StdEncoding.EncodeToString(prvKey.Serialize())
+}
@@ -0,0 +1,61 @@
+package cosmos
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/tyler-smith/go-bip32"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// TestEncodeAddress checks the first Cosmos Hub account of the abandon about mnemonic at m/44'/118'/0'/0/0
+// and that other HRPs encode the same account hash
+func TestEncodeAddress(t *testing.T) {
+	key, err := bip32.NewMasterKey(bip39.NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range []uint32{bip32.FirstHardenedChild + 44, bip32.FirstHardenedChild + 118, bip32.FirstHardenedChild, 0, 0} {
+		key, err = key.NewChildKey(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, pubKey := btcec.PrivKeyFromBytes(key.Key)
+
+	address, err := EncodeAddress("cosmos", pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4"; address != want {
+		t.Errorf("address = %s, want %s", address, want)
+	}
+
+	osmo, err := EncodeAddress("osmo", pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hrp, data, err := bech32.DecodeToBase256(osmo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, want, _ := bech32.DecodeToBase256(address)
+	if hrp != "osmo" || !bytes.Equal(data, want) {
+		t.Errorf("osmo address %s encodes %s %x, want osmo %x", osmo, hrp, data, want)
+	}
+}
+
+func TestPrivateKey(t *testing.T) {
+	raw, _ := hex.DecodeString("e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35")
+	prvKey, _ := btcec.PrivKeyFromBytes(raw)
+	if got := HexPrivateKey(prvKey); got != hex.EncodeToString(raw) {
+		t.Errorf("HexPrivateKey() = %s, want %x", got, raw)
+	}
+	if got := Base64PrivateKey(prvKey); got != base64.StdEncoding.EncodeToString(raw) {
+		t.Errorf("Base64PrivateKey() = %s, want %s", got, base64.StdEncoding.EncodeToString(raw))
+	}
+}
@@ -0,0 +1,102 @@
+// Package dcr
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package dcr
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/decred/dcrd/crypto/blake256"
+	"github.com/tyler-smith/go-bip32"
+	"golang.org/x/crypto/ripemd160"
+
+	"key-gen/btc"
+)
+
+// Network is the bip44.Chain of Decred, holding the two-byte Base58Check version prefixes of a network
+// As a bip44.Validator it rejects uncompressed keys before any key is derived
+// Decred hashes public keys with BLAKE-256 and RIPEMD-160 and checksums with double BLAKE-256
+// https://devdocs.decred.org/developer-guides/addresses/
+type Network struct {
+	Name             string
+	PubKeyHashAddrID [2]byte
+	PrivateKeyID     [2]byte
+}
+
+var (
+	Mainnet = &Network{"mainnet", [2]byte{0x07, 0x3f}, [2]byte{0x22, 0xde}} // Ds, Pm
+	Testnet = &Network{"testnet", [2]byte{0x0f, 0x21}, [2]byte{0x23, 0x0e}} // Ts, Pt
+)
+
+// signatureTypeEcdsaSecp256k1 identifies secp256k1 ECDSA keys in WIFs
+const signatureTypeEcdsaSecp256k1 byte = 0
+
+// Hash160 returns RIPEMD-160(BLAKE-256(b))
+func Hash160(b []byte) []byte {
+	sum := blake256.Sum256(b)
+	h := ripemd160.New()
+	h.Write(sum[:])
+	return h.Sum(nil)
+}
+
+// CheckEncode returns the Base58Check encoding of the payload prefixed with a two-byte version
+// The checksum is the first 4 bytes of BLAKE-256(BLAKE-256(version || payload))
+func CheckEncode(payload []byte, version [2]byte) string {
+	b := make([]byte, 0, len(version)+len(payload)+4)
+	b = append(b, version[:]...)
+	b = append(b, payload...)
+	first := blake256.Sum256(b)
+	second := blake256.Sum256(first[:])
+	b = append(b, second[:4]...)
+	return base58.Encode(b)
+}
+
+// EncodePubKeyHash returns the P2PKH address of a compressed public key, Ds on mainnet
+func (n *Network) EncodePubKeyHash(pubKey *btcec.PublicKey) string {
+	return CheckEncode(Hash160(pubKey.SerializeCompressed()), n.PubKeyHashAddrID)
+}
+
+// Supports reports whether the chain can spend the address type, Decred has no SegWit
+func (n *Network) Supports(addressType btc.AddressType) bool {
+	return addressType == btc.AddressTypeP2PKH
+}
+
+// Validate rejects uncompressed public keys, Decred addresses and WIFs only encode compressed keys
+func (n *Network) Validate(compress bool) error {
+	if !compress {
+		return fmt.Errorf("decred requires compressed public keys")
+	}
+	return nil
+}
+
+// Label returns the label of the address type
+func (n *Network) Label(addressType btc.AddressType, compress bool) string {
+	return "P2PKH(BLAKE-256)"
+}
+
+// Address returns the P2PKH address paying to the public key
+func (n *Network) Address(pubKey *btcec.PublicKey, compress bool, addressType btc.AddressType) (string, error) {
+	if !n.Supports(addressType) {
+		return "", fmt.Errorf("%s is not supported on Decred", addressType)
+	}
+	if err := n.Validate(compress); err != nil {
+		return "", err
+	}
+	return n.EncodePubKeyHash(pubKey), nil
+}
+
+// PrivateKey returns the private key in the Decred wallet import format, Pm on mainnet
+// The key is prefixed with the network and signature type, Decred keys are always compressed
+func (n *Network) PrivateKey(prvKey *btcec.PrivateKey, compress bool) (string, error) {
+	data := append([]byte{signatureTypeEcdsaSecp256k1}, prvKey.Serialize()...)
+	return CheckEncode(data, n.PrivateKeyID), nil
+}
+
+// ExtendedPublicKey returns an empty string, Decred extended keys fingerprint parents with BLAKE-256
+// which cannot be computed from the child key alone
+func (n *Network) ExtendedPublicKey(key *bip32.Key, addressType btc.AddressType) string {
+	return ""
+}
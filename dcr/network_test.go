@@ -0,0 +1,52 @@
+package dcr
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+
+	"key-gen/btc"
+)
+
+// TestCheckEncode checks the P2PKH addresses of the dcrd address tests
+// https://github.com/decred/dcrd/blob/master/txscript/stdaddr/address_test.go
+func TestCheckEncode(t *testing.T) {
+	tests := []struct {
+		name    string
+		network *Network
+		hash    string
+		address string
+	}{
+		{"mainnet", Mainnet, "2789d58cfa0957d206f025c2af056fc8a77cebb0", "DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hash, _ := hex.DecodeString(test.hash)
+			if address := CheckEncode(hash, test.network.PubKeyHashAddrID); address != test.address {
+				t.Errorf("address = %s, want %s", address, test.address)
+			}
+		})
+	}
+}
+
+// TestValidate checks that uncompressed public keys are rejected before any address is encoded
+func TestValidate(t *testing.T) {
+	_, pubKey := btcec.PrivKeyFromBytes(bytes.Repeat([]byte{0x01}, 32))
+	tests := []struct {
+		compress bool
+		wantErr  bool
+	}{
+		{true, false},
+		{false, true},
+	}
+	for _, test := range tests {
+		if err := Mainnet.Validate(test.compress); (err != nil) != test.wantErr {
+			t.Errorf("compress %v: err = %v, want error %v", test.compress, err, test.wantErr)
+		}
+		if _, err := Mainnet.Address(pubKey, test.compress, btc.AddressTypeP2PKH); (err != nil) != test.wantErr {
+			t.Errorf("compress %v: address err = %v, want error %v", test.compress, err, test.wantErr)
+		}
+	}
+}
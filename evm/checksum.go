@@ -0,0 +1,44 @@
+// Package evm
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package evm
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ChecksumAddress returns the EIP-55 mixed-case checksum encoding of the address
+// https://eips.ethereum.org/EIPS/eip-55
+func ChecksumAddress(address common.Address) string {
+	return checksum(address, "")
+}
+
+// ChainChecksumAddress returns the EIP-1191 chain-aware checksum encoding of the address
+// The checksum hashes the chain id followed by the 0x prefixed address, so it does not validate on other chains
+// https://eips.ethereum.org/EIPS/eip-1191
+func ChainChecksumAddress(address common.Address, chainID uint64) string {
+	return checksum(address, strconv.FormatUint(chainID, 10)+"0x")
+}
+
+// checksum uppercases every hex letter whose nibble in the keccak256 hash of prefix || lowercase address is 8 or more
+func checksum(address common.Address, prefix string) string {
+	lower := hex.EncodeToString(address.Bytes())
+	hash := crypto.Keccak256([]byte(prefix + lower))
+
+	result := []byte(lower)
+	for i, c := range result {
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+		if c >= 'a' && nibble&0xf >= 8 {
+			result[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(result)
+}
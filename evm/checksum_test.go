@@ -0,0 +1,47 @@
+package evm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TestChecksumAddress checks the EIP-55 test cases
+// https://eips.ethereum.org/EIPS/eip-55#test-cases
+func TestChecksumAddress(t *testing.T) {
+	tests := []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	}
+	for _, want := range tests {
+		if got := ChecksumAddress(common.HexToAddress(strings.ToLower(want))); got != want {
+			t.Errorf("ChecksumAddress() = %s, want %s", got, want)
+		}
+	}
+}
+
+// TestChainChecksumAddress checks the EIP-1191 test cases of RSK mainnet and testnet
+// https://eips.ethereum.org/EIPS/eip-1191#test-cases
+func TestChainChecksumAddress(t *testing.T) {
+	tests := []struct {
+		chainID uint64
+		want    string
+	}{
+		{30, "0x5aaEB6053f3e94c9b9a09f33669435E7ef1bEAeD"},
+		{30, "0xFb6916095cA1Df60bb79ce92cE3EA74c37c5d359"},
+		{30, "0xDBF03B407c01E7CD3cBea99509D93F8Dddc8C6FB"},
+		{30, "0xD1220A0Cf47c7B9BE7a2e6ba89F429762E7B9adB"},
+		{31, "0x5aAeb6053F3e94c9b9A09F33669435E7EF1BEaEd"},
+		{31, "0xFb6916095CA1dF60bb79CE92ce3Ea74C37c5D359"},
+		{31, "0xdbF03B407C01E7cd3cbEa99509D93f8dDDc8C6fB"},
+		{31, "0xd1220a0CF47c7B9Be7A2E6Ba89f429762E7b9adB"},
+	}
+	for _, test := range tests {
+		if got := ChainChecksumAddress(common.HexToAddress(strings.ToLower(test.want)), test.chainID); got != test.want {
+			t.Errorf("ChainChecksumAddress(%d) = %s, want %s", test.chainID, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Package filecoin
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package filecoin
+
+import (
+	"encoding/base32"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/tyler-smith/go-bip32"
+	"golang.org/x/crypto/blake2b"
+
+	"key-gen/btc"
+)
+
+// Filecoin f1 addresses are protocol 1, the BLAKE2b-160 hash of the uncompressed secp256k1 public key
+// The string form is the network prefix, the protocol and lowercase base32 of payload || BLAKE2b-32(protocol || payload)
+// https://spec.filecoin.io/appendix/address/
+const ProtocolSecp256k1 byte = 0x01
+
+var encoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// Network holds the address prefix of a Filecoin network, as a bip44.Chain it encodes secp256k1 f1 addresses
+type Network struct {
+	Name   string
+	Prefix string
+}
+
+var (
+	Mainnet = &Network{"mainnet", "f"}
+	Testnet = &Network{"testnet", "t"}
+)
+
+// KeyInfo is the key format of lotus wallet export and import, the private key marshals to base64
+type KeyInfo struct {
+	Type       string
+	PrivateKey []byte
+}
+
+func blake2bSum(size int, data ...[]byte) []byte {
+	h, _ := blake2b.New(size, nil)
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+// EncodeAddress returns the f1 address of the public key, t1 on testnet
+func (n *Network) EncodeAddress(pubKey *btcec.PublicKey) string {
+	payload := blake2bSum(20, pubKey.SerializeUncompressed())
+	checksum := blake2bSum(4, []byte{ProtocolSecp256k1}, payload)
+	return fmt.Sprintf("%s%d%s", n.Prefix, ProtocolSecp256k1, encoding.EncodeToString(append(payload, checksum...)))
+}
+
+// LotusExport returns the hex of the KeyInfo JSON, the format lotus wallet import reads
+func LotusExport(prvKey *btcec.PrivateKey) (string, error) {
+	b, err := json.Marshal(KeyInfo{Type: "secp256k1", PrivateKey: prvKey.Serialize()})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (n *Network) Supports(addressType btc.AddressType) bool {
+	return addressType == btc.AddressTypeP2PKH
+}
+
+func (n *Network) Label(addressType btc.AddressType, compress bool) string {
+	return n.Prefix + "1(BLAKE2b-160)"
+}
+
+// Address returns the f1 address of the public key
+// The hash is taken over the uncompressed key, so compress does not change the address
+func (n *Network) Address(pubKey *btcec.PublicKey, compress bool, addressType btc.AddressType) (string, error) {
+	if !n.Supports(addressType) {
+		return "", fmt.Errorf("%s is not supported on Filecoin", addressType)
+	}
+	return n.EncodeAddress(pubKey), nil
+}
+
+// PrivateKey returns the private key in the lotus export format
+func (n *Network) PrivateKey(prvKey *btcec.PrivateKey, compress bool) (string, error) {
+	return LotusExport(prvKey)
+}
+
+func (n *Network) PrivateKeyFormat() string {
+	return "Lotus Key Export(hex)"
+}
+
+func (n *Network) ExtendedPublicKey(key *bip32.Key, addressType btc.AddressType) string {
+	return ""
+}
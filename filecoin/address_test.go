@@ -0,0 +1,66 @@
+package filecoin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+
+	"key-gen/btc"
+)
+
+// TestEncodeAddress checks the secp256k1 vector of go-address, mainnet only changes the network prefix
+// https://github.com/filecoin-project/go-address/blob/master/address_test.go
+func TestEncodeAddress(t *testing.T) {
+	pubKey, err := btcec.ParsePubKey([]byte{4, 148, 2, 250, 195, 126, 100, 50, 164, 22, 163, 160, 202, 84, 38, 181, 24, 90, 179, 178, 79, 97, 52, 239, 162, 92, 228, 135, 200, 45, 46, 78, 19, 191, 69, 37, 17, 224, 210, 36, 84, 33, 248, 97, 59, 193, 13, 114, 250, 33, 102, 102, 169, 108, 59, 193, 57, 32, 211, 255, 35, 63, 208, 188, 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		network  *Network
+		compress bool
+		want     string
+	}{
+		{Testnet, false, "t15ihq5ibzwki2b4ep2f46avlkrqzhpqgtga7pdrq"},
+		{Mainnet, false, "f15ihq5ibzwki2b4ep2f46avlkrqzhpqgtga7pdrq"},
+		{Mainnet, true, "f15ihq5ibzwki2b4ep2f46avlkrqzhpqgtga7pdrq"},
+	}
+	for _, test := range tests {
+		got, err := test.network.Address(pubKey, test.compress, btc.AddressTypeP2PKH)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%s Address(compress %v) = %s, want %s", test.network.Name, test.compress, got, test.want)
+		}
+	}
+	if _, err := Mainnet.Address(pubKey, true, btc.AddressTypeP2WPKH); err == nil {
+		t.Error("Address(P2WPKH) error = nil, want unsupported")
+	}
+}
+
+// TestLotusExport checks that the export decodes as the KeyInfo lotus wallet import reads
+func TestLotusExport(t *testing.T) {
+	keyBytes, _ := hex.DecodeString("0c28fca386c7a227600b2fe50b7cae11ec86d3bf1fbe471be89827e19d72aa1d")
+	prvKey, _ := btcec.PrivKeyFromBytes(keyBytes)
+	export, err := Mainnet.PrivateKey(prvKey, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := hex.DecodeString(export)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"Type":"secp256k1","PrivateKey":"DCj8o4bHoidgCy/lC3yuEeyG078fvkcb6Jgn4Z1yqh0="}`
+	if string(b) != want {
+		t.Errorf("KeyInfo = %s, want %s", b, want)
+	}
+	var keyInfo KeyInfo
+	if err := json.Unmarshal(b, &keyInfo); err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(keyInfo.PrivateKey) != hex.EncodeToString(keyBytes) {
+		t.Errorf("PrivateKey = %x, want %x", keyInfo.PrivateKey, keyBytes)
+	}
+}
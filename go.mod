@@ -3,10 +3,12 @@ module key-gen
 go 1.22.5
 
 require (
+	filippo.io/edwards25519 v1.1.0
 	github.com/1password/onepassword-sdk-go v0.1.0-beta.12
 	github.com/btcsuite/btcd v0.24.2
 	github.com/btcsuite/btcd/btcec/v2 v2.3.2
 	github.com/btcsuite/btcd/btcutil v1.1.5
+	github.com/decred/dcrd/crypto/blake256 v1.0.0
 	github.com/ethereum/go-ethereum v1.14.7
 	github.com/tyler-smith/go-bip32 v1.0.0
 	github.com/tyler-smith/go-bip39 v1.1.0
@@ -18,7 +20,6 @@ require (
 	github.com/FactomProject/btcutilecc v0.0.0-20130527213604-d3a63a5752ec // indirect
 	github.com/btcsuite/btcd/chaincfg/chainhash v1.1.0 // indirect
 	github.com/btcsuite/btclog v0.0.0-20170628155309-84c8d2346e9f // indirect
-	github.com/decred/dcrd/crypto/blake256 v1.0.0 // indirect
 	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.0.1 // indirect
 	github.com/extism/go-sdk v1.3.1 // indirect
 	github.com/fsnotify/fsnotify v1.7.0 // indirect
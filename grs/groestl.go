@@ -0,0 +1,139 @@
+// Package grs
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package grs
+
+import (
+	"encoding/binary"
+)
+
+// Grøstl : a SHA-3 candidate, the 512 bit variant used by Groestlcoin
+// https://www.groestl.info/Groestl.pdf
+const (
+	groestlBlockSize = 128 // bytes of the 1024 bit state of Grøstl-512
+	groestlRounds    = 14
+)
+
+var (
+	sbox = aesSbox()
+
+	shiftP = [8]int{0, 1, 2, 3, 4, 5, 6, 11}
+	shiftQ = [8]int{1, 3, 5, 11, 0, 2, 4, 6}
+	mixRow = [8]byte{0x02, 0x02, 0x03, 0x04, 0x05, 0x03, 0x05, 0x07}
+)
+
+// gfMul multiplies in GF(2^8) modulo the AES polynomial x^8 + x^4 + x^3 + x + 1
+func gfMul(a, b byte) byte {
+	var p byte
+	for b > 0 {
+		if b&1 == 1 {
+			p ^= a
+		}
+		carry := a & 0x80
+		a <<= 1
+		if carry != 0 {
+			a ^= 0x1b
+		}
+		b >>= 1
+	}
+	return p
+}
+
+// aesSbox computes the AES S-box, the affine transform of the multiplicative inverse
+func aesSbox() [256]byte {
+	var s [256]byte
+	for i := 0; i < 256; i++ {
+		// a^254 is the inverse of a, and 0 maps to 0
+		inv, x := byte(1), byte(i)
+		for e := 254; e > 0; e >>= 1 {
+			if e&1 == 1 {
+				inv = gfMul(inv, x)
+			}
+			x = gfMul(x, x)
+		}
+		if i == 0 {
+			inv = 0
+		}
+		b := inv
+		for r := 1; r <= 4; r++ {
+			b ^= inv<<r | inv>>(8-r)
+		}
+		s[i] = b ^ 0x63
+	}
+	return s
+}
+
+// permute applies the P or Q permutation to the state, stored column by column
+func permute(state *[groestlBlockSize]byte, q bool) {
+	shift := &shiftP
+	if q {
+		shift = &shiftQ
+	}
+	var t [groestlBlockSize]byte
+	for r := 0; r < groestlRounds; r++ {
+		// AddRoundConstant
+		for j := 0; j < 16; j++ {
+			if q {
+				for i := 0; i < 7; i++ {
+					state[8*j+i] ^= 0xff
+				}
+				state[8*j+7] ^= 0xff ^ byte(j<<4) ^ byte(r)
+			} else {
+				state[8*j] ^= byte(j<<4) ^ byte(r)
+			}
+		}
+		// SubBytes and ShiftBytes
+		for j := 0; j < 16; j++ {
+			for i := 0; i < 8; i++ {
+				t[8*j+i] = sbox[state[8*((j+shift[i])%16)+i]]
+			}
+		}
+		// MixBytes
+		for j := 0; j < 16; j++ {
+			column := t[8*j : 8*j+8]
+			for i := 0; i < 8; i++ {
+				var b byte
+				for k := 0; k < 8; k++ {
+					b ^= gfMul(mixRow[(k-i+8)%8], column[k])
+				}
+				state[8*j+i] = b
+			}
+		}
+	}
+}
+
+// Sum512 returns the Grøstl-512 digest of data
+func Sum512(data []byte) [64]byte {
+	// Pad with a 1 bit, zeros and the 64 bit number of blocks so the message fills whole blocks
+	blocks := (len(data) + 1 + 8 + groestlBlockSize - 1) / groestlBlockSize
+	padded := make([]byte, blocks*groestlBlockSize)
+	copy(padded, data)
+	padded[len(data)] = 0x80
+	binary.BigEndian.PutUint64(padded[len(padded)-8:], uint64(blocks))
+
+	var h [groestlBlockSize]byte
+	binary.BigEndian.PutUint16(h[groestlBlockSize-2:], 512)
+
+	for b := 0; b < blocks; b++ {
+		var p, q [groestlBlockSize]byte
+		copy(q[:], padded[b*groestlBlockSize:(b+1)*groestlBlockSize])
+		for i := range p {
+			p[i] = h[i] ^ q[i]
+		}
+		permute(&p, false)
+		permute(&q, true)
+		for i := range h {
+			h[i] ^= p[i] ^ q[i]
+		}
+	}
+
+	// Output transformation, the last 512 bits of P(h) xor h
+	x := h
+	permute(&x, false)
+	var digest [64]byte
+	for i := range digest {
+		digest[i] = x[groestlBlockSize-64+i] ^ h[groestlBlockSize-64+i]
+	}
+	return digest
+}
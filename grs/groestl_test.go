@@ -0,0 +1,43 @@
+package grs
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// sequence returns n bytes counting up from 0 and wrapping at 256
+func sequence(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
+// TestSum512 checks Grøstl-512 against the digests of the reference implementation
+// The longer messages cross the 128 byte block and the 119/120 byte boundary where the padding spills into a second block,
+// their digests come from an independent implementation of the specification that reproduces the "" and "abc" digests
+func TestSum512(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   []byte
+		digest string
+	}{
+		{`""`, []byte(""), "6d3ad29d279110eef3adbd66de2a0345a77baede1557f5d099fce0c03d6dc2ba8e6d4a6633dfbd66053c20faa87d1a11f39a7fbe4a6c2f009801370308fc4ad8"},
+		{`"abc"`, []byte("abc"), "70e1c68c60df3b655339d67dc291cc3f1dde4ef343f11b23fdd44957693815a75a8339c682fc28322513fd1f283c18e53cff2b264e06bf83a2f0ac8c1f6fbff6"},
+		{"111 bytes", sequence(111), "74c38fd7e6ab3254277d3bd7367fc5cbdef35cdba14ef1de5fc0fa26ce97f283aa17b2c79bd776464eaa2f8754c1b3153d50eac9ca931fe0453e62fb76349773"},
+		{"112 bytes", sequence(112), "529b1189ad74ec37596754cab0424b7299d7dd51c639b72d05a485204ab9263d96bec062bf7bd4664f4ed82052684aa3e46adab55a4bbc4fac30ffead1e7123e"},
+		{"119 bytes", sequence(119), "b37602eb3cb6226e83ce18695d15f19f7e01afff69f4a76103afb789d073a757fc6d97242e80ee92e0953d8617174375ae5227581c1630098e3048bc5bfdfc5a"},
+		{"120 bytes", sequence(120), "5cfc13a05459f11cab784846d953da0b7c3eda4855db918da20993665b7e7260cb3711782f402c04b49a03f70414246d56217e97e261cef8f0c225fd124cb971"},
+		{"128 bytes", sequence(128), "70b56b15a86cd65b19f4afe78f7b408b72287947cc0d28ba4189573fbe033cf9a3298127b460778feecca5794407539acc267b27732e4fbc21bc96fcf9f2f17a"},
+		{"200 bytes", sequence(200), "ff6dabc4aacd1f3955daba7ee2f36b2e24cca8aef87bdf286ea77b2d86dc40526ca5290c0558e95b4f620d78241a2665ab300216016b66ae87c6dc2e216348bb"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sum := Sum512(test.data)
+			if got := hex.EncodeToString(sum[:]); got != test.digest {
+				t.Errorf("Sum512(%s) = %s, want %s", test.name, got, test.digest)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Package grs
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package grs
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/tyler-smith/go-bip32"
+
+	"key-gen/btc"
+)
+
+// Network implements bip44.Chain for Groestlcoin, Base58Check strings carry a double Grøstl-512 checksum
+// Public key and script hashes and bech32 addresses are the same as on Bitcoin
+type Network struct {
+	*btc.Network
+}
+
+var (
+	Mainnet = newNetwork("groestlcoin", &chaincfg.MainNetParams, btc.Mainnet, 0x24, 0x05, 0x80, "grs")           // F, 3, grs1
+	Testnet = newNetwork("groestlcoin-testnet", &chaincfg.TestNet3Params, btc.Testnet, 0x6f, 0xc4, 0xef, "tgrs") // m or n, 2, tgrs1
+)
+
+func newNetwork(name string, base *chaincfg.Params, versions *btc.Network, pubKeyHashAddrID, scriptHashAddrID, privateKeyID byte, bech32HRP string) *Network {
+	params := *base
+	params.Name = name
+	params.PubKeyHashAddrID = pubKeyHashAddrID
+	params.ScriptHashAddrID = scriptHashAddrID
+	params.PrivateKeyID = privateKeyID
+	params.Bech32HRPSegwit = bech32HRP
+	return &Network{&btc.Network{
+		Name:                name,
+		Params:              &params,
+		AddressTypes:        []btc.AddressType{btc.AddressTypeP2PKH, btc.AddressTypeP2SHP2WPKH, btc.AddressTypeP2WPKH},
+		PublicVersion:       versions.PublicVersion,
+		PublicVersionNested: versions.PublicVersionNested,
+		PublicVersionNative: versions.PublicVersionNative,
+	}}
+}
+
+// Checksum returns the first 4 bytes of Grøstl-512(Grøstl-512(b))
+func Checksum(b []byte) []byte {
+	first := Sum512(b)
+	second := Sum512(first[:])
+	return second[:4]
+}
+
+// CheckEncode returns the Base58Check encoding of the payload prefixed with the version, using the Grøstl checksum
+func CheckEncode(payload []byte, version byte) string {
+	b := make([]byte, 0, 1+len(payload)+4)
+	b = append(b, version)
+	b = append(b, payload...)
+	b = append(b, Checksum(b)...)
+	return base58.Encode(b)
+}
+
+// Address returns the address of the given type paying to the public key, Base58Check addresses carry the Grøstl checksum
+func (n *Network) Address(pubKey *btcec.PublicKey, compress bool, addressType btc.AddressType) (string, error) {
+	if err := addressType.Validate(compress); err != nil {
+		return "", err
+	}
+	if !n.Supports(addressType) {
+		return "", fmt.Errorf("%s is not supported on %s", addressType, n.Name)
+	}
+
+	serializedPubKey := pubKey.SerializeUncompressed()
+	if compress {
+		serializedPubKey = pubKey.SerializeCompressed()
+	}
+	pubKeyHash := btcutil.Hash160(serializedPubKey)
+
+	switch addressType {
+	case btc.AddressTypeP2PKH:
+		return CheckEncode(pubKeyHash, n.Params.PubKeyHashAddrID), nil
+	case btc.AddressTypeP2SHP2WPKH:
+		// the redeem script is the version 0 witness program OP_0 <20 byte pubkey hash>
+		redeemScript := append([]byte{0x00, 0x14}, pubKeyHash...)
+		return CheckEncode(btcutil.Hash160(redeemScript), n.Params.ScriptHashAddrID), nil
+	}
+	// bech32 addresses use the standard checksum
+	return n.Network.Address(pubKey, compress, addressType)
+}
+
+// PrivateKey returns the private key in wallet import format with the Grøstl checksum
+func (n *Network) PrivateKey(prvKey *btcec.PrivateKey, compress bool) (string, error) {
+	payload := prvKey.Serialize()
+	if compress {
+		payload = append(payload, 0x01)
+	}
+	return CheckEncode(payload, n.Params.PrivateKeyID), nil
+}
+
+// ExtendedPublicKey returns the neutered key serialized with the SLIP-0132 version bytes of the address type and the Grøstl checksum
+func (n *Network) ExtendedPublicKey(key *bip32.Key, addressType btc.AddressType) string {
+	pub := key.PublicKey()
+	pub.Version = n.PublicVersionOf(addressType)
+	serialized, err := pub.Serialize()
+	if err != nil {
+		return ""
+	}
+	// replace the double SHA-256 checksum appended by go-bip32
+	serialized = serialized[:len(serialized)-4]
+	return base58.Encode(append(serialized, Checksum(serialized)...))
+}
@@ -0,0 +1,119 @@
+package grs
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/tyler-smith/go-bip32"
+	"github.com/tyler-smith/go-bip39"
+
+	"key-gen/btc"
+)
+
+// deriveKey derives the key at m/purpose'/17'/0'/change/index from the mnemonic
+func deriveKey(t *testing.T, mnemonic string, purpose, change, index uint32) *bip32.Key {
+	t.Helper()
+	key, err := bip32.NewMasterKey(bip39.NewSeed(mnemonic, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range []uint32{bip32.FirstHardenedChild + purpose, bip32.FirstHardenedChild + 17, bip32.FirstHardenedChild, change, index} {
+		key, err = key.NewChildKey(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return key
+}
+
+// TestAddress checks the Groestlcoin addresses of the Trezor firmware tests for the all all all mnemonic
+// https://github.com/trezor/trezor-firmware/blob/main/tests/device_tests/bitcoin/test_getaddress.py
+func TestAddress(t *testing.T) {
+	tests := []struct {
+		purpose     uint32
+		change      uint32
+		index       uint32
+		addressType btc.AddressType
+		address     string
+	}{
+		{44, 0, 0, btc.AddressTypeP2PKH, "Fj62rBJi8LvbmWu2jzkaUX1NFXLEqDLoZM"},
+		{44, 1, 0, btc.AddressTypeP2PKH, "FmRaqvVBRrAp2Umfqx9V1ectZy8gw54QDN"},
+		{84, 0, 0, btc.AddressTypeP2WPKH, "grs1qw4teyraux2s77nhjdwh9ar8rl9dt7zww8r6lne"},
+	}
+	for _, test := range tests {
+		t.Run(test.address, func(t *testing.T) {
+			key := deriveKey(t, "all all all all all all all all all all all all", test.purpose, test.change, test.index)
+			pubKey, err := btcec.ParsePubKey(key.PublicKey().Key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			address, err := Mainnet.Address(pubKey, true, test.addressType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if address != test.address {
+				t.Errorf("address = %s, want %s", address, test.address)
+			}
+		})
+	}
+}
+
+// TestPrivateKey checks that WIFs carry the version, key, compression flag and Grøstl checksum
+func TestPrivateKey(t *testing.T) {
+	key := deriveKey(t, "all all all all all all all all all all all all", 44, 0, 0)
+	prvKey, _ := btcec.PrivKeyFromBytes(key.Key)
+	tests := []struct {
+		compress bool
+		payload  []byte
+	}{
+		{true, append(append([]byte{0x80}, key.Key...), 0x01)},
+		{false, append([]byte{0x80}, key.Key...)},
+	}
+	for _, test := range tests {
+		wif, err := Mainnet.PrivateKey(prvKey, test.compress)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded := base58.Decode(wif)
+		payload, checksum := decoded[:len(decoded)-4], decoded[len(decoded)-4:]
+		if !bytes.Equal(payload, test.payload) {
+			t.Errorf("compress %v: payload = %x, want %x", test.compress, payload, test.payload)
+		}
+		if !bytes.Equal(checksum, Checksum(payload)) {
+			t.Errorf("compress %v: checksum = %x, want %x", test.compress, checksum, Checksum(payload))
+		}
+	}
+}
+
+// TestExtendedPublicKey checks the account xpubs of the all all all mnemonic, which carry the Grøstl checksum
+// The payloads are those of the Bitcoin keys at the same paths, only the version bytes and checksum differ
+func TestExtendedPublicKey(t *testing.T) {
+	tests := []struct {
+		purpose     uint32
+		addressType btc.AddressType
+		xpub        string
+	}{
+		{44, btc.AddressTypeP2PKH, "xpub6DGa4qjCDqDNhLWUCEPJSETizUdexA2i5k3wUG2QboRNa4MNJV4k5XthorGcogStY5K5iJ6NHtsznNK599ir8PmA3d1jqEoZHsixDTddNA9"},
+		{49, btc.AddressTypeP2SHP2WPKH, "ypub6Xjmceh6vnQmEBzujdqTNLaLZFmimKu8d6yse1UzRefUaS7BiPYY64tnYpQQydp1gnb2cGkccBd1RtHRDtGXagqmRLxTStV88GWaeYh8ndG"},
+		{84, btc.AddressTypeP2WPKH, "zpub6qXFnWiY6FdT5BQptrzEhHfm1WpaBTFc6MHzR4KwscXGdt6xCqUtrAEjrHdeEsjaYEwVMgjtTvENQ83yo2fmkYYGjTpJoH7vFWKQJp1bg1X"},
+	}
+	for _, test := range tests {
+		t.Run(test.xpub, func(t *testing.T) {
+			key, err := bip32.NewMasterKey(bip39.NewSeed("all all all all all all all all all all all all", ""))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, i := range []uint32{bip32.FirstHardenedChild + test.purpose, bip32.FirstHardenedChild + 17, bip32.FirstHardenedChild} {
+				key, err = key.NewChildKey(i)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if xpub := Mainnet.ExtendedPublicKey(key, test.addressType); xpub != test.xpub {
+				t.Errorf("ExtendedPublicKey() = %s, want %s", xpub, test.xpub)
+			}
+		})
+	}
+}
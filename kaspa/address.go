@@ -0,0 +1,93 @@
+// Package kaspa
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package kaspa
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/tyler-smith/go-bip32"
+
+	"key-gen/bch"
+	"key-gen/btc"
+)
+
+// Kaspa addresses are the network prefix, a colon and the CashAddr encoding of a version byte followed by the public key
+// Unlike Bitcoin Cash the key is not hashed, version 0 carries the x-only Schnorr key and version 1 the compressed ECDSA key
+// https://github.com/kaspanet/rusty-kaspa/blob/master/crypto/addresses/src/lib.rs
+const (
+	VersionSchnorr byte = 0
+	VersionECDSA   byte = 1
+)
+
+// Network holds the address prefix of a Kaspa network
+// Its bip44.Chain addresses pay to Schnorr keys, the bip44.SecondaryAddresser ones to ECDSA keys
+type Network struct {
+	Name   string
+	Prefix string
+}
+
+var (
+	Mainnet = &Network{"mainnet", "kaspa"}
+	Testnet = &Network{"testnet", "kaspatest"}
+)
+
+// EncodeAddress returns the address of the payload with the given version, e.g. kaspa:qr...
+func (n *Network) EncodeAddress(version byte, payload []byte) string {
+	return n.Prefix + ":" + bch.Encode(n.Prefix, append([]byte{version}, payload...))
+}
+
+// SchnorrAddress returns the version 0 address of the public key, the one Kaspa wallets receive to
+func (n *Network) SchnorrAddress(pubKey *btcec.PublicKey) string {
+	return n.EncodeAddress(VersionSchnorr, schnorr.SerializePubKey(pubKey))
+}
+
+// ECDSAAddress returns the version 1 address of the public key
+func (n *Network) ECDSAAddress(pubKey *btcec.PublicKey) string {
+	return n.EncodeAddress(VersionECDSA, pubKey.SerializeCompressed())
+}
+
+func (n *Network) Supports(addressType btc.AddressType) bool {
+	return addressType == btc.AddressTypeP2PKH
+}
+
+// Label returns the name of the Schnorr address encoding
+func (n *Network) Label(addressType btc.AddressType, compress bool) string {
+	return "Schnorr(version 0)"
+}
+
+// Address returns the Schnorr address of the public key
+func (n *Network) Address(pubKey *btcec.PublicKey, compress bool, addressType btc.AddressType) (string, error) {
+	if !n.Supports(addressType) {
+		return "", fmt.Errorf("%s is not supported on Kaspa", addressType)
+	}
+	return n.SchnorrAddress(pubKey), nil
+}
+
+// SecondaryLabel returns the name of the ECDSA address encoding
+func (n *Network) SecondaryLabel(compress bool) string {
+	return "ECDSA(version 1)"
+}
+
+// SecondaryAddress returns the ECDSA address of the public key
+func (n *Network) SecondaryAddress(pubKey *btcec.PublicKey, compress bool) (string, error) {
+	return n.ECDSAAddress(pubKey), nil
+}
+
+// PrivateKey returns the private key as hex, the format kaspawallet and the Kaspa web wallets import
+func (n *Network) PrivateKey(prvKey *btcec.PrivateKey, compress bool) (string, error) {
+	return hex.EncodeToString(prvKey.Serialize()), nil
+}
+
+func (n *Network) PrivateKeyFormat() string {
+	return "Private Key(hex)"
+}
+
+// ExtendedPublicKey returns an empty string, Kaspa kpub keys are not exported
+func (n *Network) ExtendedPublicKey(key *bip32.Key, addressType btc.AddressType) string {
+	return ""
+}
@@ -0,0 +1,65 @@
+package kaspa
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/tyler-smith/go-bip32"
+	"github.com/tyler-smith/go-bip39"
+
+	"key-gen/btc"
+)
+
+// TestEncodeAddress checks the public key vectors of rusty-kaspa
+// https://github.com/kaspanet/rusty-kaspa/blob/master/crypto/addresses/src/lib.rs
+func TestEncodeAddress(t *testing.T) {
+	tests := []struct {
+		network *Network
+		version byte
+		payload []byte
+		want    string
+	}{
+		{Mainnet, VersionSchnorr, make([]byte, 32), "kaspa:qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqkx9awp4e"},
+		{Testnet, VersionSchnorr, make([]byte, 32), "kaspatest:qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqhqrxplya"},
+	}
+	for _, test := range tests {
+		if got := test.network.EncodeAddress(test.version, test.payload); got != test.want {
+			t.Errorf("%s EncodeAddress(%d) = %s, want %s", test.network.Name, test.version, got, test.want)
+		}
+	}
+}
+
+// TestAddress checks the first Kaspa address of the abandon about mnemonic at m/44'/111111'/0'/0/0
+// The ECDSA address carries the compressed key, so it starts with version 1 followed by 02 or 03
+func TestAddress(t *testing.T) {
+	key, err := bip32.NewMasterKey(bip39.NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range []uint32{bip32.FirstHardenedChild + 44, bip32.FirstHardenedChild + 111111, bip32.FirstHardenedChild, 0, 0} {
+		key, err = key.NewChildKey(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, pubKey := btcec.PrivKeyFromBytes(key.Key)
+
+	address, err := Mainnet.Address(pubKey, true, btc.AddressTypeP2PKH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "kaspa:qqd6e65yefepe9wk0m9vuxdufxd80sphy67gwwd0vdaumzdt4tc9s3qt0lqeh"; address != want {
+		t.Errorf("Address() = %s, want %s", address, want)
+	}
+	secondary, err := Mainnet.SecondaryAddress(pubKey, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(secondary, "kaspa:qy") || secondary != Mainnet.ECDSAAddress(pubKey) {
+		t.Errorf("SecondaryAddress() = %s, want the kaspa:qy ECDSA address", secondary)
+	}
+	if _, err := Mainnet.Address(pubKey, true, btc.AddressTypeP2WPKH); err == nil {
+		t.Error("Address(P2WPKH) error = nil, want unsupported")
+	}
+}
@@ -83,7 +83,7 @@ func Decrypt(filePath string, password string) ([]byte, error) {
 	return plainText, nil
 }
 
-func (s *FSSaver) Save(ctx context.Context, config util.KeyConfig, manager *bip44.KeyManager) error {
+func (s *FSSaver) Save(ctx context.Context, config util.KeyConfig, manager bip44.Exporter) error {
 	currentTime := time.Now()
 	fileExt := ".json"
 	if config.Encrypt {
@@ -94,7 +94,11 @@ func (s *FSSaver) Save(ctx context.Context, config util.KeyConfig, manager *bip4
 	fmt.Println(strings.Repeat("-", 106))
 	fmt.Printf("%-18s %s\n", "File Path:", s.filePath)
 
-	jsn, err := manager.ToJSON(config.Accounts, config.Compressed)
+	kmj, err := manager.Export(config.ExportOptions())
+	if err != nil {
+		return err
+	}
+	jsn, err := kmj.ToJSON()
 	if err != nil {
 		return err
 	}
@@ -38,6 +38,14 @@ func sections() []onepassword.ItemSection {
 			ID:    "wallet",
 			Title: "Wallet",
 		},
+		{
+			ID:    "extendedKeys",
+			Title: "Extended Public Keys",
+		},
+		{
+			ID:    "descriptors",
+			Title: "Output Descriptors",
+		},
 		{
 			ID:    "evmAccounts",
 			Title: "EVM Accounts",
@@ -46,6 +54,18 @@ func sections() []onepassword.ItemSection {
 			ID:    "bitcoinAccounts",
 			Title: "Bitcoin Accounts",
 		},
+		{
+			ID:    "xrpAccounts",
+			Title: "XRP Accounts",
+		},
+		{
+			ID:    "cosmosAccounts",
+			Title: "Cosmos Accounts",
+		},
+		{
+			ID:    "coinAccounts",
+			Title: "Coin Accounts",
+		},
 	}
 }
 
@@ -71,7 +91,43 @@ func walletPrivateKeyItem(id string, title string, value string, sectionID strin
 	return itemField(fmt.Sprintf("walletPrivateKey%s", id), title, value, onepassword.ItemFieldTypeConcealed, sectionID)
 }
 
-func (s *OPSaver) Save(ctx context.Context, config util.KeyConfig, manager *bip44.KeyManager) error {
+// accountItems returns the address, path and private key fields of every account
+// The master key is labelled Master and the derived accounts are numbered from 1
+func accountItems(idPrefix string, titlePrefix string, accounts []bip44.KeyAccountJSON, sectionID string) []onepassword.ItemField {
+	var fields []onepassword.ItemField
+	n := 0
+	for i, account := range accounts {
+		label := "Master"
+		if account.Path != "m" {
+			n++
+			label = fmt.Sprintf("%d", n)
+		}
+		fields = append(fields, walletAddressItem(fmt.Sprintf("%sAddress%d", idPrefix, i), fmt.Sprintf("%s%s #%s", titlePrefix, account.KeyType, label), account.Address, sectionID))
+		fields = append(fields, walletPathItem(fmt.Sprintf("%sPath%d", idPrefix, i), fmt.Sprintf("Path #%s", label), account.Path, sectionID))
+		if account.PrivateKey != "" {
+			fields = append(fields, walletPrivateKeyItem(fmt.Sprintf("%sPrivateKey%d", idPrefix, i), fmt.Sprintf("Private Key #%s", label), account.PrivateKey, sectionID))
+		}
+	}
+	return fields
+}
+
+// cosmosItems returns the address, path and private key fields of every account of a Cosmos chain
+// Each account carries its private key twice, as hex and as base64
+func cosmosItems(chain bip44.CosmosAccountsJSON, sectionID string) []onepassword.ItemField {
+	var fields []onepassword.ItemField
+	idPrefix := "Cosmos" + strings.ToUpper(chain.Symbol)
+	for i, account := range chain.Accounts {
+		fields = append(fields, walletAddressItem(fmt.Sprintf("%sAddress%d", idPrefix, i), fmt.Sprintf("%s #%d", account.KeyType, i+1), account.Address, sectionID))
+		fields = append(fields, walletPathItem(fmt.Sprintf("%sPath%d", idPrefix, i), fmt.Sprintf("Path #%d", i+1), account.Path, sectionID))
+		if account.PrivateKey != "" {
+			fields = append(fields, walletPrivateKeyItem(fmt.Sprintf("%sPrivateKey%d", idPrefix, i), fmt.Sprintf("Private Key(hex) #%d", i+1), account.PrivateKey, sectionID))
+			fields = append(fields, walletPrivateKeyItem(fmt.Sprintf("%sPrivateKeyBase64%d", idPrefix, i), fmt.Sprintf("Private Key(base64) #%d", i+1), account.PrivateKeyBase64, sectionID))
+		}
+	}
+	return fields
+}
+
+func (s *OPSaver) Save(ctx context.Context, config util.KeyConfig, manager bip44.Exporter) error {
 	currentTime := time.Now()
 	itemName := fmt.Sprintf("%s (%s)", config.Name, currentTime.Format(time.ANSIC))
 	fmt.Printf("\n%-18s \n", "1Password")
@@ -79,7 +135,7 @@ func (s *OPSaver) Save(ctx context.Context, config util.KeyConfig, manager *bip4
 	fmt.Printf("%-18s %s\n", "1Password Item Name:", itemName)
 
 	//Save the data from the manager
-	mk, err := manager.MainKey()
+	kmj, err := manager.Export(config.ExportOptions())
 	if err != nil {
 		return err
 	}
@@ -91,73 +147,54 @@ func (s *OPSaver) Save(ctx context.Context, config util.KeyConfig, manager *bip4
 	for _, section := range itemSections {
 		// Create the sections
 		if section.ID == "wallet" {
-			fields = append(fields, itemField("recoveryPhrase", "recovery phrase", manager.Mnemonic, onepassword.ItemFieldTypeConcealed, section.ID))
-			if manager.Passphrase != "" {
-				fields = append(fields, itemField("password", "mnemonic password", manager.Passphrase, onepassword.ItemFieldTypeConcealed, section.ID))
+			fields = append(fields, itemField("network", "network", kmj.Network, onepassword.ItemFieldTypeText, section.ID))
+		}
+		if section.ID == "wallet" && kmj.Mnemonic != "" {
+			fields = append(fields, itemField("recoveryPhrase", "recovery phrase", kmj.Mnemonic, onepassword.ItemFieldTypeConcealed, section.ID))
+			if kmj.Passphrase != "" {
+				fields = append(fields, itemField("password", "mnemonic password", kmj.Passphrase, onepassword.ItemFieldTypeConcealed, section.ID))
 			}
 			if config.GlobalConfig.Password != "" {
 				fields = append(fields, itemField("password", "password", config.GlobalConfig.Password, onepassword.ItemFieldTypeConcealed, section.ID))
 			} else {
 				fields = append(fields, itemField("password", "password", util.RandString(PwComplexity), onepassword.ItemFieldTypeConcealed, section.ID))
 			}
-			fields = append(fields, itemField("seed", "seed", fmt.Sprintf("%x", manager.Seed()), onepassword.ItemFieldTypeConcealed, section.ID))
-			fields = append(fields, itemField("root key", "root key", mk.Base58Key(), onepassword.ItemFieldTypeConcealed, section.ID))
+			fields = append(fields, itemField("seed", "seed", kmj.Seed, onepassword.ItemFieldTypeConcealed, section.ID))
+			fields = append(fields, itemField("root key", "root key", kmj.RootKey, onepassword.ItemFieldTypeConcealed, section.ID))
+			fields = append(fields, itemField("masterFingerprint", "master fingerprint", kmj.MasterFingerprint, onepassword.ItemFieldTypeText, section.ID))
 		}
-		if section.ID == "evmAccounts" {
-			fields = append(fields, walletAddressItem(fmt.Sprintf("EVMAddress%s", "Master"), fmt.Sprintf("Address #%s", "Master"), mk.EVMAddress.Hex(), section.ID))
-			fields = append(fields, walletPathItem(fmt.Sprintf("EVMPath%s", "Master"), fmt.Sprintf("Path #%s", "Master"), mk.Path, section.ID))
-			fields = append(fields, walletPrivateKeyItem(fmt.Sprintf("EVMPrivateKey%s", "Master"), fmt.Sprintf("Private Key #%s", "Master"), mk.HexKey(), section.ID))
-			for i := 0; i < config.Accounts; i++ {
-				key, err := manager.Key(bip44.PurposeBIP44, bip44.CoinTypeEthereum, 0, 0, uint32(i))
-				if err != nil {
-					return err
+		if section.ID == "extendedKeys" {
+			for i, key := range kmj.ExtendedKeys {
+				if key.KeyOrigin != "" {
+					fields = append(fields, itemField(fmt.Sprintf("extendedKeyOrigin%d", i), fmt.Sprintf("Key Origin %s", key.KeyType), key.KeyOrigin, onepassword.ItemFieldTypeText, section.ID))
 				}
-				fields = append(fields, walletAddressItem(fmt.Sprintf("EVMAddress%d", i), fmt.Sprintf("Address #%d", i+1), key.EVMAddress.Hex(), section.ID))
-				fields = append(fields, walletPathItem(fmt.Sprintf("EVMPath%d", i), fmt.Sprintf("Path #%d", i+1), key.Path, section.ID))
-				fields = append(fields, walletPrivateKeyItem(fmt.Sprintf("EVMPrivateKey%d", i), fmt.Sprintf("Private Key #%d", i+1), key.HexKey(), section.ID))
+				fields = append(fields, itemField(fmt.Sprintf("extendedPublicKey%d", i), key.KeyType, key.PublicKey, onepassword.ItemFieldTypeText, section.ID))
+			}
+		}
+		if section.ID == "descriptors" {
+			for i, descriptor := range kmj.Descriptors {
+				title := fmt.Sprintf("%s %s", descriptor.KeyType, descriptor.Chain)
+				fields = append(fields, itemField(fmt.Sprintf("descriptor%d", i), fmt.Sprintf("%s descriptor", title), descriptor.Descriptor, onepassword.ItemFieldTypeText, section.ID))
+				fields = append(fields, itemField(fmt.Sprintf("privateDescriptor%d", i), fmt.Sprintf("%s private descriptor", title), descriptor.Private, onepassword.ItemFieldTypeConcealed, section.ID))
 			}
 		}
+		if section.ID == "evmAccounts" {
+			fields = append(fields, accountItems("EVM", "", kmj.EVMAccounts, section.ID)...)
+		}
 		if section.ID == "bitcoinAccounts" {
-			for i := 0; i < config.Accounts; i++ {
-				legacyKey, err := manager.Key(bip44.PurposeBIP44, bip44.CoinTypeBitcoin, 0, 0, uint32(i))
-				if err != nil {
-					return err
-				}
-				legacy, err := legacyKey.NewWIF(config.Compressed)
-				if err != nil {
-					return err
-				}
-				swnKey, err := manager.Key(bip44.PurposeBIP49, bip44.CoinTypeBitcoin, 0, 0, uint32(i))
-				if err != nil {
-					return err
-				}
-				swn, err := swnKey.NewWIF(config.Compressed)
-				if err != nil {
-					return err
-				}
-				swn32Key, err := manager.Key(bip44.PurposeBIP84, bip44.CoinTypeBitcoin, 0, 0, uint32(i))
-				if err != nil {
-					return err
-				}
-				swn32, err := swn32Key.NewWIF(config.Compressed)
-				if err != nil {
-					return err
-				}
-				tprKey, err := manager.Key(bip44.PurposeBIP86, bip44.CoinTypeBitcoin, 0, 0, uint32(i))
-				if err != nil {
-					return err
-				}
-				tpr, err := tprKey.NewWIF(config.Compressed)
-				if err != nil {
-					return err
-				}
-
-				fields = append(fields, walletAddressItem(fmt.Sprintf("BTCLegacy%d", i), fmt.Sprintf("Bitcoin Legacy(P2PKH, compresed) #%d", i+1), legacy.Address, section.ID))
-				fields = append(fields, walletAddressItem(fmt.Sprintf("BTCSegWit%d", i), fmt.Sprintf("Bitcoin SegWit(P2WPKH-nested-in-P2SH) #%d", i+1), swn.SegwitNested, section.ID))
-				fields = append(fields, walletAddressItem(fmt.Sprintf("BTCSegWit32%d", i), fmt.Sprintf("Bitcoin SegWit(P2WPKH, bech32) #%d", i+1), swn32.SegwitBech32, section.ID))
-				fields = append(fields, walletAddressItem(fmt.Sprintf("BTCTaproot%d", i), fmt.Sprintf("Bitcoin Taproot(P2TR, bech32m) #%d", i+1), tpr.Taproot, section.ID))
-				fields = append(fields, walletPathItem(fmt.Sprintf("BTCPath%d", i), fmt.Sprintf("Path #%d", i+1), legacyKey.Path, section.ID))
-				fields = append(fields, walletPrivateKeyItem(fmt.Sprintf("BTCWIF%d", i), fmt.Sprintf("Bitcoin WIF(Wallet Import Format) #%d", i+1), legacy.WIFString, section.ID))
+			fields = append(fields, accountItems("BTC", "Bitcoin ", kmj.BitcoinAccounts, section.ID)...)
+		}
+		if section.ID == "xrpAccounts" {
+			fields = append(fields, accountItems("XRP", "XRP ", kmj.XRPAccounts, section.ID)...)
+		}
+		if section.ID == "cosmosAccounts" {
+			for _, chain := range kmj.CosmosAccounts {
+				fields = append(fields, cosmosItems(chain, section.ID)...)
+			}
+		}
+		if section.ID == "coinAccounts" {
+			for _, coin := range kmj.CoinAccounts {
+				fields = append(fields, accountItems(strings.ToUpper(coin.Symbol), coin.Name+" ", coin.Accounts, section.ID)...)
 			}
 		}
 	}
@@ -0,0 +1,87 @@
+package save
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/1password/onepassword-sdk-go"
+
+	"key-gen/bip44"
+)
+
+// fieldTitles returns the ID and title of every field
+func fieldTitles(fields []onepassword.ItemField) [][2]string {
+	titles := make([][2]string, 0, len(fields))
+	for _, field := range fields {
+		titles = append(titles, [2]string{field.ID, field.Title})
+	}
+	return titles
+}
+
+// TestAccountItems checks that the master key is labelled Master, derived accounts are numbered from 1 and watch-only rows have no private key field
+func TestAccountItems(t *testing.T) {
+	tests := []struct {
+		name     string
+		accounts []bip44.KeyAccountJSON
+		want     [][2]string
+	}{
+		{
+			"master and derived",
+			[]bip44.KeyAccountJSON{
+				{Path: "m", Address: "0xMaster", PrivateKey: "0x01", KeyType: "Address"},
+				{Path: "m/44'/60'/0'/0/0", Address: "0xFirst", PrivateKey: "0x02", KeyType: "Address"},
+			},
+			[][2]string{
+				{"walletAddressEVMAddress0", "Address #Master"},
+				{"walletPathEVMPath0", "Path #Master"},
+				{"walletPrivateKeyEVMPrivateKey0", "Private Key #Master"},
+				{"walletAddressEVMAddress1", "Address #1"},
+				{"walletPathEVMPath1", "Path #1"},
+				{"walletPrivateKeyEVMPrivateKey1", "Private Key #1"},
+			},
+		},
+		{
+			"watch-only",
+			[]bip44.KeyAccountJSON{
+				{Path: "m/44'/60'/0'/0/5", Address: "0xSixth", KeyType: "Address"},
+			},
+			[][2]string{
+				{"walletAddressEVMAddress0", "Address #1"},
+				{"walletPathEVMPath0", "Path #1"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := fieldTitles(accountItems("EVM", "", test.accounts, "evmAccounts")); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("accountItems() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+// TestCosmosItems checks that every Cosmos account stores its private key both as hex and as base64
+func TestCosmosItems(t *testing.T) {
+	chain := bip44.CosmosAccountsJSON{
+		Symbol: "atom",
+		Accounts: []bip44.CosmosAccountJSON{
+			{
+				KeyAccountJSON:   bip44.KeyAccountJSON{Path: "m/44'/118'/0'/0/0", Address: "cosmos1first", PrivateKey: "01", KeyType: "Address"},
+				PrivateKeyBase64: "AQ==",
+			},
+		},
+	}
+	want := [][2]string{
+		{"walletAddressCosmosATOMAddress0", "Address #1"},
+		{"walletPathCosmosATOMPath0", "Path #1"},
+		{"walletPrivateKeyCosmosATOMPrivateKey0", "Private Key(hex) #1"},
+		{"walletPrivateKeyCosmosATOMPrivateKeyBase640", "Private Key(base64) #1"},
+	}
+	fields := cosmosItems(chain, "cosmosAccounts")
+	if got := fieldTitles(fields); !reflect.DeepEqual(got, want) {
+		t.Errorf("cosmosItems() = %v, want %v", got, want)
+	}
+	if fields[3].Value != "AQ==" {
+		t.Errorf("base64 private key = %s, want AQ==", fields[3].Value)
+	}
+}
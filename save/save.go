@@ -12,7 +12,7 @@ import (
 )
 
 type Saver interface {
-	Save(ctx context.Context, config util.KeyConfig, manager *bip44.KeyManager) error
+	Save(ctx context.Context, config util.KeyConfig, manager bip44.Exporter) error
 }
 
 type Save struct {
@@ -43,7 +43,7 @@ func NewSave(config util.KeyConfig) (*Save, error) {
 	}, nil
 }
 
-func (s *Save) Save(ctx context.Context, manager *bip44.KeyManager) error {
+func (s *Save) Save(ctx context.Context, manager bip44.Exporter) error {
 	for _, saver := range s.savers {
 		err := saver.Save(ctx, s.config, manager)
 		if err != nil {
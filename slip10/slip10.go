@@ -0,0 +1,65 @@
+// Package slip10
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package slip10
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+)
+
+// SLIP-0010 : Universal private key derivation from master private key
+// https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+// Only the ed25519 curve is implemented, secp256k1 keys are derived with go-bip32
+
+const FirstHardenedIndex uint32 = 0x80000000 // 0'
+
+// ed25519Curve is the HMAC key of the master key generation
+var ed25519Curve = []byte("ed25519 seed")
+
+// ErrNonHardenedIndex is returned when deriving a normal child, ed25519 has no public parent to public child derivation
+var ErrNonHardenedIndex = errors.New("ed25519 keys only support hardened derivation")
+
+// Key is an ed25519 private key and its chain code
+type Key struct {
+	Key       []byte // 32-byte private key, the ed25519 seed
+	ChainCode []byte
+}
+
+// NewMasterKey returns the master key of a BIP39 seed, HMAC-SHA512("ed25519 seed", seed)
+func NewMasterKey(seed []byte) *Key {
+	mac := hmac.New(sha512.New, ed25519Curve)
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	return &Key{Key: sum[:32], ChainCode: sum[32:]}
+}
+
+// NewChildKey returns the hardened child at index, HMAC-SHA512(chain code, 0x00 || key || index)
+func (k *Key) NewChildKey(index uint32) (*Key, error) {
+	if index < FirstHardenedIndex {
+		return nil, ErrNonHardenedIndex
+	}
+	data := make([]byte, 0, 37)
+	data = append(data, 0x00)
+	data = append(data, k.Key...)
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	mac := hmac.New(sha512.New, k.ChainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return &Key{Key: sum[:32], ChainCode: sum[32:]}, nil
+}
+
+// PrivateKey returns the 64-byte ed25519 private key, the seed followed by the public key
+func (k *Key) PrivateKey() ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(k.Key)
+}
+
+// PublicKey returns the 32-byte ed25519 public key
+func (k *Key) PublicKey() ed25519.PublicKey {
+	return k.PrivateKey().Public().(ed25519.PublicKey)
+}
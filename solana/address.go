@@ -0,0 +1,52 @@
+// Package solana
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package solana
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+)
+
+// Network is the bip44.Ed25519Chain of Solana, whose accounts are ed25519 public keys
+// Addresses are the base58 encoding of the 32-byte key on every cluster, so the type holds no state
+// https://solana.com/docs/core/accounts
+type Network struct{}
+
+// EncodeAddress returns the base58 address of the public key
+func EncodeAddress(pubKey ed25519.PublicKey) string {
+	return base58.Encode(pubKey)
+}
+
+// Keypair returns the 64-byte keypair as the JSON array of numbers solana-keygen writes to its keypair files
+func Keypair(prvKey ed25519.PrivateKey) (string, error) {
+	numbers := make([]int, len(prvKey))
+	for i, b := range prvKey {
+		numbers[i] = int(b)
+	}
+	b, err := json.Marshal(numbers)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (Network) Label() string {
+	return "Address(base58)"
+}
+
+func (Network) Address(pubKey ed25519.PublicKey) (string, error) {
+	return EncodeAddress(pubKey), nil
+}
+
+// PrivateKey returns the keypair in the solana-keygen JSON format
+func (Network) PrivateKey(prvKey ed25519.PrivateKey) (string, error) {
+	return Keypair(prvKey)
+}
+
+func (Network) PrivateKeyFormat() string {
+	return "Keypair(solana-keygen JSON)"
+}
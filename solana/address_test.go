@@ -0,0 +1,66 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"testing"
+
+	"github.com/tyler-smith/go-bip39"
+
+	"key-gen/slip10"
+)
+
+// deriveKey derives the Phantom and Solflare path m/44'/501'/account'/0' of the abandon mnemonic
+func deriveKey(t *testing.T, account uint32) ed25519.PrivateKey {
+	t.Helper()
+	seed := bip39.NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	key := slip10.NewMasterKey(seed)
+	for _, index := range []uint32{44, 501, account, 0} {
+		var err error
+		key, err = key.NewChildKey(slip10.FirstHardenedIndex + index)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return ed25519.NewKeyFromSeed(key.Key)
+}
+
+func TestAddress(t *testing.T) {
+	tests := []struct {
+		account uint32
+		want    string
+	}{
+		{0, "HAgk14JpMQLgt6rVgv7cBQFJWFto5Dqxi472uT3DKpqk"},
+	}
+	for _, test := range tests {
+		prvKey := deriveKey(t, test.account)
+		got, err := Network{}.Address(prvKey.Public().(ed25519.PublicKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("Address(account %d) = %s, want %s", test.account, got, test.want)
+		}
+	}
+}
+
+// TestKeypair checks that the keypair round-trips as the 64 numbers of a solana-keygen file
+func TestKeypair(t *testing.T) {
+	prvKey := deriveKey(t, 0)
+	keypair, err := Network{}.PrivateKey(prvKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ints []int
+	if err := json.Unmarshal([]byte(keypair), &ints); err != nil {
+		t.Fatal(err)
+	}
+	if len(ints) != ed25519.PrivateKeySize {
+		t.Fatalf("keypair has %d numbers, want %d", len(ints), ed25519.PrivateKeySize)
+	}
+	for i, n := range ints {
+		if byte(n) != prvKey[i] || n > 255 {
+			t.Fatalf("keypair[%d] = %d, want %d", i, n, prvKey[i])
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Package stellar
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package stellar
+
+import (
+	"crypto/ed25519"
+	"encoding/base32"
+	"encoding/binary"
+)
+
+// StrKey : version byte || payload || CRC16-XModem little-endian, base32 encoded without padding
+// https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0023.md
+const (
+	VersionAccountID byte = 6 << 3  // G
+	VersionSeed      byte = 18 << 3 // S
+)
+
+// Network encodes Stellar accounts as a bip44.Ed25519Chain, the public and test networks share the StrKey encoding
+type Network struct{}
+
+// crc16 returns the CRC16-XModem checksum, polynomial 0x1021 with a zero initial value
+func crc16(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// Encode returns the StrKey encoding of the payload with the given version byte
+func Encode(version byte, payload []byte) string {
+	b := make([]byte, 0, len(payload)+3)
+	b = append(b, version)
+	b = append(b, payload...)
+	b = binary.LittleEndian.AppendUint16(b, crc16(b))
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)
+}
+
+func (Network) Label() string {
+	return "Account(StrKey)"
+}
+
+// Address returns the G... account id of the public key
+func (Network) Address(pubKey ed25519.PublicKey) (string, error) {
+	return Encode(VersionAccountID, pubKey), nil
+}
+
+// PrivateKey returns the S... secret seed, the 32-byte ed25519 seed of the key
+func (Network) PrivateKey(prvKey ed25519.PrivateKey) (string, error) {
+	return Encode(VersionSeed, prvKey.Seed()), nil
+}
+
+func (Network) PrivateKeyFormat() string {
+	return "Secret Seed(StrKey)"
+}
@@ -0,0 +1,65 @@
+package stellar
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/tyler-smith/go-bip39"
+
+	"key-gen/slip10"
+)
+
+// sep5Key derives m/44'/148'/account' from the mnemonic as SEP-0005 specifies
+func sep5Key(t *testing.T, mnemonic string, passphrase string, account uint32) *slip10.Key {
+	t.Helper()
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := slip10.NewMasterKey(seed)
+	for _, index := range []uint32{44, 148, account} {
+		key, err = key.NewChildKey(slip10.FirstHardenedIndex + index)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return key
+}
+
+// TestSEP5 checks the accounts of the SEP-0005 test vectors
+// https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0005.md#test-cases
+func TestSEP5(t *testing.T) {
+	tests := []struct {
+		mnemonic   string
+		passphrase string
+		account    uint32
+		address    string
+		secret     string
+	}{
+		{"illness spike retreat truth genius clock brain pass fit cave bargain toe", "", 0, "GDRXE2BQUC3AZNPVFSCEZ76NJ3WWL25FYFK6RGZGIEKWE4SOOHSUJUJ6", "SBGWSG6BTNCKCOB3DIFBGCVMUPQFYPA2G4O34RMTB343OYPXU5DJDVMN"},
+		{"illness spike retreat truth genius clock brain pass fit cave bargain toe", "", 1, "GBAW5XGWORWVFE2XTJYDTLDHXTY2Q2MO73HYCGB3XMFMQ562Q2W2GJQX", "SCEPFFWGAG5P2VX5DHIYK3XEMZYLTYWIPWYEKXFHSK25RVMIUNJ7CTIS"},
+		{"illness spike retreat truth genius clock brain pass fit cave bargain toe", "", 2, "GAY5PRAHJ2HIYBYCLZXTHID6SPVELOOYH2LBPH3LD4RUMXUW3DOYTLXW", "SDAILLEZCSA67DUEP3XUPZJ7NYG7KGVRM46XA7K5QWWUIGADUZCZWTJP"},
+		{"cable spray genius state float twenty onion head street palace net private method loan turn phrase state blanket interest dry amazing dress blast tube", "p4ssphr4se", 0, "GDAHPZ2NSYIIHZXM56Y36SBVTV5QKFIZGYMMBHOU53ETUSWTP62B63EQ", "SAFWTGXVS7ELMNCXELFWCFZOPMHUZ5LXNBGUVRCY3FHLFPXK4QPXYP2X"},
+		{"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "", 0, "GB3JDWCQJCWMJ3IILWIGDTQJJC5567PGVEVXSCVPEQOTDN64VJBDQBYX", "SBUV3MRWKNS6AYKZ6E6MOUVF2OYMON3MIUASWL3JLY5E3ISDJFELYBRZ"},
+		{"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "", 1, "GDVSYYTUAJ3ACHTPQNSTQBDQ4LDHQCMNY4FCEQH5TJUMSSLWQSTG42MV", "SCHDCVCWGAKGIMTORV6K5DYYV3BY4WG3RA4M6MCBGJLHUCWU2MC6DL66"},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%.20s/%d", test.mnemonic, test.account), func(t *testing.T) {
+			key := sep5Key(t, test.mnemonic, test.passphrase, test.account)
+			address, err := Network{}.Address(key.PublicKey())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if address != test.address {
+				t.Errorf("address = %s, want %s", address, test.address)
+			}
+			secret, err := Network{}.PrivateKey(key.PrivateKey())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if secret != test.secret {
+				t.Errorf("secret = %s, want %s", secret, test.secret)
+			}
+		})
+	}
+}
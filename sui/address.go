@@ -0,0 +1,42 @@
+// Package sui
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package sui
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"golang.org/x/crypto/blake2b"
+)
+
+// Sui addresses are BLAKE2b-256(flag || public key), the flag byte names the signature scheme
+// https://docs.sui.io/concepts/cryptography/transaction-auth/keys-addresses
+const FlagEd25519 byte = 0x00
+
+// PrivateKeyHRP prefixes the bech32 private keys of SIP-15, suiprivkey1...
+const PrivateKeyHRP = "suiprivkey"
+
+// Network implements bip44.Ed25519Chain for Sui accounts, every network shares the encoding
+type Network struct{}
+
+func (Network) Label() string {
+	return "Address(BLAKE2b-256)"
+}
+
+// Address returns the 0x prefixed hex address of the public key
+func (Network) Address(pubKey ed25519.PublicKey) (string, error) {
+	sum := blake2b.Sum256(append([]byte{FlagEd25519}, pubKey...))
+	return fmt.Sprintf("0x%x", sum), nil
+}
+
+// PrivateKey returns the bech32 encoding of flag || 32-byte private key, the format sui keytool imports
+func (Network) PrivateKey(prvKey ed25519.PrivateKey) (string, error) {
+	return bech32.EncodeFromBase256(PrivateKeyHRP, append([]byte{FlagEd25519}, prvKey.Seed()...))
+}
+
+func (Network) PrivateKeyFormat() string {
+	return "Private Key(suiprivkey)"
+}
@@ -0,0 +1,51 @@
+package sui
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/tyler-smith/go-bip39"
+
+	"key-gen/slip10"
+)
+
+// TestAddress checks the ed25519 keypair vectors of the Sui TypeScript SDK at m/44'/784'/0'/0'/0'
+// https://github.com/MystenLabs/sui/blob/main/sdk/typescript/test/unit/cryptography/ed25519-keypair.test.ts
+func TestAddress(t *testing.T) {
+	tests := []struct {
+		mnemonic   string
+		address    string
+		privateKey string
+	}{
+		{
+			"film crazy soon outside stand loop subway crumble thrive popular green nuclear struggle pistol arm wife phrase warfare march wheat nephew ask sunny firm",
+			"0xa2d14fad60c56049ecf75246a481934691214ce413e6a8ae2fe6834c173a6133",
+			"suiprivkey1qrwsjvr6gwaxmsvxk4cfun99ra8uwxg3c9pl0nhle7xxpe4s80y05ctazer",
+		},
+	}
+	for _, test := range tests {
+		key := slip10.NewMasterKey(bip39.NewSeed(test.mnemonic, ""))
+		for _, index := range []uint32{44, 784, 0, 0, 0} {
+			var err error
+			key, err = key.NewChildKey(slip10.FirstHardenedIndex + index)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		prvKey := ed25519.NewKeyFromSeed(key.Key)
+		address, err := Network{}.Address(prvKey.Public().(ed25519.PublicKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if address != test.address {
+			t.Errorf("Address() = %s, want %s", address, test.address)
+		}
+		privateKey, err := Network{}.PrivateKey(prvKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if privateKey != test.privateKey {
+			t.Errorf("PrivateKey() = %s, want %s", privateKey, test.privateKey)
+		}
+	}
+}
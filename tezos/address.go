@@ -0,0 +1,111 @@
+// Package tezos
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package tezos
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/tyler-smith/go-bip32"
+	"golang.org/x/crypto/blake2b"
+
+	"key-gen/btc"
+)
+
+// Tezos Base58Check prefixes, several bytes long so every encoding starts with a readable tag
+// https://gitlab.com/tezos/tezos/-/blob/master/src/lib_crypto/base58.ml
+var (
+	PrefixTz1  = []byte{6, 161, 159}       // tz1, ed25519 public key hash
+	PrefixTz2  = []byte{6, 161, 161}       // tz2, secp256k1 public key hash
+	PrefixEdsk = []byte{13, 15, 58, 7}     // edsk, 32-byte ed25519 seed
+	PrefixSpsk = []byte{17, 162, 224, 201} // spsk, secp256k1 secret key
+)
+
+// Ed25519Network is the bip44.Ed25519Chain of tz1 accounts, derived with SLIP-0010, the test networks share the mainnet encoding
+type Ed25519Network struct {
+	Name string
+}
+
+// Secp256k1Network is the bip44.Chain of tz2 accounts, derived with BIP32, the test networks share the mainnet encoding
+type Secp256k1Network struct {
+	Name string
+}
+
+var (
+	Tz1 = &Ed25519Network{"mainnet"}
+	Tz2 = &Secp256k1Network{"mainnet"}
+)
+
+// CheckEncode returns the Base58Check encoding of prefix || payload with a double SHA-256 checksum
+func CheckEncode(prefix []byte, payload []byte) string {
+	b := make([]byte, 0, len(prefix)+len(payload)+4)
+	b = append(b, prefix...)
+	b = append(b, payload...)
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	b = append(b, second[:4]...)
+	return base58.Encode(b)
+}
+
+// PublicKeyHash returns the BLAKE2b-160 hash of a serialized public key
+func PublicKeyHash(pubKey []byte) []byte {
+	h, _ := blake2b.New(20, nil)
+	h.Write(pubKey)
+	return h.Sum(nil)
+}
+
+// Label returns the name of the tz1 address encoding
+func (n *Ed25519Network) Label() string {
+	return "tz1(ed25519)"
+}
+
+// Address returns the tz1 address of an ed25519 public key
+func (n *Ed25519Network) Address(pubKey ed25519.PublicKey) (string, error) {
+	return CheckEncode(PrefixTz1, PublicKeyHash(pubKey)), nil
+}
+
+// PrivateKey returns the edsk encoding of the 32-byte ed25519 seed
+func (n *Ed25519Network) PrivateKey(prvKey ed25519.PrivateKey) (string, error) {
+	return CheckEncode(PrefixEdsk, prvKey.Seed()), nil
+}
+
+// PrivateKeyFormat returns the label of the secret key encodings, edsk for tz1 and spsk for tz2
+func (n *Ed25519Network) PrivateKeyFormat() string {
+	return "Secret Key(Base58Check)"
+}
+
+func (n *Secp256k1Network) Supports(addressType btc.AddressType) bool {
+	return addressType == btc.AddressTypeP2PKH
+}
+
+// Label returns the name of the tz2 address encoding
+func (n *Secp256k1Network) Label(addressType btc.AddressType, compress bool) string {
+	return "tz2(secp256k1)"
+}
+
+// Address returns the tz2 address of the compressed public key
+func (n *Secp256k1Network) Address(pubKey *btcec.PublicKey, compress bool, addressType btc.AddressType) (string, error) {
+	if !n.Supports(addressType) {
+		return "", fmt.Errorf("%s is not supported on Tezos", addressType)
+	}
+	return CheckEncode(PrefixTz2, PublicKeyHash(pubKey.SerializeCompressed())), nil
+}
+
+// PrivateKey returns the spsk encoding of the secret key
+func (n *Secp256k1Network) PrivateKey(prvKey *btcec.PrivateKey, compress bool) (string, error) {
+	return CheckEncode(PrefixSpsk, prvKey.Serialize()), nil
+}
+
+// PrivateKeyFormat returns the label of the secret key encodings, edsk for tz1 and spsk for tz2
+func (n *Secp256k1Network) PrivateKeyFormat() string {
+	return "Secret Key(Base58Check)"
+}
+
+func (n *Secp256k1Network) ExtendedPublicKey(key *bip32.Key, addressType btc.AddressType) string {
+	return ""
+}
@@ -0,0 +1,87 @@
+package tezos
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/tyler-smith/go-bip39"
+
+	"key-gen/btc"
+	"key-gen/slip10"
+)
+
+// checkDecode returns the payload of a Base58Check string after the prefix, failing on a bad checksum
+func checkDecode(t *testing.T, s string, prefix []byte) []byte {
+	t.Helper()
+	b := base58.Decode(s)
+	if len(b) < len(prefix)+4 || !bytes.Equal(b[:len(prefix)], prefix) {
+		t.Fatalf("%s does not start with prefix %v", s, prefix)
+	}
+	payload := b[len(prefix) : len(b)-4]
+	if CheckEncode(prefix, payload) != s {
+		t.Fatalf("%s has an invalid checksum", s)
+	}
+	return payload
+}
+
+// TestEd25519Address checks the alice bootstrap account of the Flextesa sandbox
+// https://tezos.gitlab.io/flextesa/
+func TestEd25519Address(t *testing.T) {
+	secretKey := "edsk3QoqBuvdamxouPhin7swCvkQNgq4jP5KZPbwWNnwdZpSpJiEbq"
+	prvKey := ed25519.NewKeyFromSeed(checkDecode(t, secretKey, PrefixEdsk))
+	address, err := Tz1.Address(prvKey.Public().(ed25519.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb"; address != want {
+		t.Errorf("Address() = %s, want %s", address, want)
+	}
+	if got, _ := Tz1.PrivateKey(prvKey); got != secretKey {
+		t.Errorf("PrivateKey() = %s, want %s", got, secretKey)
+	}
+}
+
+// TestSecp256k1Address checks the spsk signer vector of Taquito
+// https://github.com/ecadlabs/taquito/blob/master/packages/taquito-signer/test/taquito-signer.spec.ts
+func TestSecp256k1Address(t *testing.T) {
+	secretKey := "spsk2rBDDeUqakQ42nBHDGQTtP3GErb6AahHPwF9bhca3Q5KA5HESE"
+	prvKey, _ := btcec.PrivKeyFromBytes(checkDecode(t, secretKey, PrefixSpsk))
+	for _, compress := range []bool{true, false} {
+		address, err := Tz2.Address(prvKey.PubKey(), compress, btc.AddressTypeP2PKH)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "tz2Ch1abG7FNiibmV26Uzgdsnfni9XGrk5wD"; address != want {
+			t.Errorf("Address(compress %v) = %s, want %s", compress, address, want)
+		}
+	}
+	if got, _ := Tz2.PrivateKey(prvKey, true); got != secretKey {
+		t.Errorf("PrivateKey() = %s, want %s", got, secretKey)
+	}
+	if _, err := Tz2.Address(prvKey.PubKey(), true, btc.AddressTypeP2TR); err == nil {
+		t.Error("Address(P2TR) error = nil, want unsupported")
+	}
+}
+
+// TestDerivedAddress checks the first tz1 account of the abandon about mnemonic at m/44'/1729'/0'/0'
+func TestDerivedAddress(t *testing.T) {
+	key := slip10.NewMasterKey(bip39.NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", ""))
+	for _, index := range []uint32{44, 1729, 0, 0} {
+		var err error
+		key, err = key.NewChildKey(slip10.FirstHardenedIndex + index)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	prvKey := ed25519.NewKeyFromSeed(key.Key)
+	address, err := Tz1.Address(prvKey.Public().(ed25519.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "tz1VQA4RP4fLjEEMW2FR4pE9kAg5abb5h5GL"; address != want {
+		t.Errorf("Address() = %s, want %s", address, want)
+	}
+}
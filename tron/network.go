@@ -0,0 +1,63 @@
+// Package tron
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package tron
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip32"
+
+	"key-gen/btc"
+)
+
+// AddressID prefixes Tron addresses, which encode the same Keccak-256 account hash as EVM addresses
+// https://developers.tron.network/docs/account#account-address-format
+const AddressID byte = 0x41 // T
+
+// Network implements bip44.Chain for Tron addresses, the Shasta and Nile test networks share the mainnet encoding
+type Network struct {
+	Name string
+}
+
+var Mainnet = &Network{"mainnet"}
+
+// EncodeAddress returns the Base58Check encoding of an EVM address prefixed with 0x41, e.g. TUEZSdKsoDHQMeZwihtdoBiN46zxhGWYdH
+func EncodeAddress(address common.Address) string {
+	return base58.CheckEncode(address.Bytes(), AddressID)
+}
+
+func (n *Network) Supports(addressType btc.AddressType) bool {
+	return addressType == btc.AddressTypeP2PKH
+}
+
+func (n *Network) Label(addressType btc.AddressType, compress bool) string {
+	return "Base58Check(Keccak-256)"
+}
+
+// Address returns the Tron address of the public key
+// The account hash is taken over the uncompressed key, so compress does not change the address
+func (n *Network) Address(pubKey *btcec.PublicKey, compress bool, addressType btc.AddressType) (string, error) {
+	if !n.Supports(addressType) {
+		return "", fmt.Errorf("%s is not supported on Tron", addressType)
+	}
+	return EncodeAddress(crypto.PubkeyToAddress(*pubKey.ToECDSA())), nil
+}
+
+// PrivateKey returns the private key as hex, the format Tron wallets import
+func (n *Network) PrivateKey(prvKey *btcec.PrivateKey, compress bool) (string, error) {
+	return fmt.Sprintf("%x", prvKey.Serialize()), nil
+}
+
+func (n *Network) PrivateKeyFormat() string {
+	return "Private Key(hex)"
+}
+
+func (n *Network) ExtendedPublicKey(key *bip32.Key, addressType btc.AddressType) string {
+	return ""
+}
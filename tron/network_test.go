@@ -0,0 +1,49 @@
+package tron
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tyler-smith/go-bip32"
+	"github.com/tyler-smith/go-bip39"
+
+	"key-gen/btc"
+)
+
+// TestEncodeAddress checks the hex and Base58Check forms of the address in the Tron documentation
+// https://developers.tron.network/docs/account#account-address-format
+func TestEncodeAddress(t *testing.T) {
+	address := common.HexToAddress("8840e6c55b9ada326d211d818c34a994aeced808")
+	if got, want := EncodeAddress(address), "TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeL"; got != want {
+		t.Errorf("EncodeAddress() = %s, want %s", got, want)
+	}
+}
+
+// TestAddress checks the first Tron address of the abandon about mnemonic at m/44'/195'/0'/0/0
+func TestAddress(t *testing.T) {
+	key, err := bip32.NewMasterKey(bip39.NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range []uint32{bip32.FirstHardenedChild + 44, bip32.FirstHardenedChild + 195, bip32.FirstHardenedChild, 0, 0} {
+		key, err = key.NewChildKey(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	pubKey, err := btcec.ParsePubKey(key.PublicKey().Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, compress := range []bool{true, false} {
+		address, err := Mainnet.Address(pubKey, compress, btc.AddressTypeP2PKH)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "TUEZSdKsoDHQMeZwihtdoBiN46zxhGWYdH"; address != want {
+			t.Errorf("compress %v: address = %s, want %s", compress, address, want)
+		}
+	}
+}
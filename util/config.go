@@ -2,14 +2,26 @@ package util
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+
+	"key-gen/bip44"
+	"key-gen/btc"
 )
 
 const (
 	DefaultAccounts = 1
 	DefaultName     = "Generated Wallet"
+	DefaultChange   = "external"
+	DefaultNetwork  = "mainnet"
+)
+
+var (
+	DefaultPurposes  = []string{"44", "49", "84", "86"}
+	DefaultCoins     = []string{"btc"}
+	DefaultEVMChains = []string{"eth"}
 )
 
 type GlobalConfig struct {
@@ -31,6 +43,15 @@ type KeyConfig struct {
 	GlobalConfig    *GlobalConfig
 	Mnemonic        string
 	Accounts        int
+	Account         bip44.HardenedIndex
+	Changes         []bip44.Index
+	StartIndex      bip44.Index
+	Purposes        []bip44.Purpose
+	Coins           []*bip44.Coin
+	EVMChains       []*bip44.EVMChain
+	CosmosChains    []*bip44.CosmosChain
+	Network         *btc.Network
+	XRPTag          *uint32
 	Name            string
 	EncryptMnemonic bool
 	Compressed      bool
@@ -43,6 +64,22 @@ type GenerateConfig struct {
 	Save      bool
 }
 
+type WatchConfig struct {
+	KeyConfig   *KeyConfig
+	ExtendedKey string
+	Purpose     bip44.Purpose
+	Save        bool
+}
+
+type DeriveConfig struct {
+	GlobalConfig    *GlobalConfig
+	Mnemonic        string
+	Path            string
+	Network         *btc.Network
+	EncryptMnemonic bool
+	Compressed      bool
+}
+
 func NewGlobalConfig(flagSet *pflag.FlagSet) (*GlobalConfig, error) {
 	password, err := flagSet.GetString("password")
 	if err != nil {
@@ -66,10 +103,11 @@ func NewGlobalConfig(flagSet *pflag.FlagSet) (*GlobalConfig, error) {
 }
 
 func NewOPConfig(flagSet *pflag.FlagSet) (*OPConfig, error) {
+	viper.SetEnvPrefix("op")
 	viper.AutomaticEnv()
 
-	serviceAccountToken := viper.GetString("service_account_token")
-	vaultID := viper.GetString("vault_id")
+	serviceAccountToken := flagOrEnv(flagSet, "op-service-account-token", "service_account_token")
+	vaultID := flagOrEnv(flagSet, "op-vault-id", "vault_id")
 
 	if serviceAccountToken != "" && vaultID == "" {
 		return nil, fmt.Errorf("a vault id is required when using a service account token")
@@ -81,6 +119,15 @@ func NewOPConfig(flagSet *pflag.FlagSet) (*OPConfig, error) {
 	}, nil
 }
 
+// flagOrEnv returns the flag of the running command when it is set and the OP_ environment variable of the key otherwise
+// Flags are read from the command's own flag set, so commands defining the same flag never shadow each other
+func flagOrEnv(flagSet *pflag.FlagSet, name string, key string) string {
+	if flag := flagSet.Lookup(name); flag != nil && flag.Changed {
+		return flag.Value.String()
+	}
+	return viper.GetString(key)
+}
+
 func NewKeyConfig(flagSet *pflag.FlagSet, encrypt bool) (*KeyConfig, error) {
 	globalConfig, err := NewGlobalConfig(flagSet)
 	if err != nil {
@@ -97,6 +144,87 @@ func NewKeyConfig(flagSet *pflag.FlagSet, encrypt bool) (*KeyConfig, error) {
 		return nil, err
 	}
 
+	accountLevel, err := flagSet.GetUint32("account")
+	if err != nil {
+		return nil, err
+	}
+	account, err := bip44.NewHardenedIndex(accountLevel)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account: %w", err)
+	}
+
+	change, err := flagSet.GetString("change")
+	if err != nil {
+		return nil, err
+	}
+	changes, err := ParseChange(change)
+	if err != nil {
+		return nil, err
+	}
+
+	startLevel, err := flagSet.GetUint32("start-index")
+	if err != nil {
+		return nil, err
+	}
+	startIndex, err := bip44.NewIndex(startLevel)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start index: %w", err)
+	}
+
+	purposeLevels, err := flagSet.GetStringSlice("purposes")
+	if err != nil {
+		return nil, err
+	}
+	purposes, err := ParsePurposes(purposeLevels)
+	if err != nil {
+		return nil, err
+	}
+
+	coinSymbols, err := flagSet.GetStringSlice("coin")
+	if err != nil {
+		return nil, err
+	}
+	coins, err := ParseCoins(coinSymbols)
+	if err != nil {
+		return nil, err
+	}
+
+	evmChainSymbols, err := flagSet.GetStringSlice("evm-chains")
+	if err != nil {
+		return nil, err
+	}
+	evmChains, err := ParseEVMChains(evmChainSymbols)
+	if err != nil {
+		return nil, err
+	}
+
+	cosmosChainSymbols, err := flagSet.GetStringSlice("cosmos-chains")
+	if err != nil {
+		return nil, err
+	}
+	cosmosChains, err := ParseCosmosChains(cosmosChainSymbols)
+	if err != nil {
+		return nil, err
+	}
+
+	networkName, err := flagSet.GetString("network")
+	if err != nil {
+		return nil, err
+	}
+	network, err := btc.ParseNetwork(networkName)
+	if err != nil {
+		return nil, err
+	}
+
+	var xrpTag *uint32
+	if flagSet.Changed("xrp-tag") {
+		tag, err := flagSet.GetUint32("xrp-tag")
+		if err != nil {
+			return nil, err
+		}
+		xrpTag = &tag
+	}
+
 	name, err := flagSet.GetString("name")
 	if err != nil {
 		return nil, err
@@ -133,16 +261,115 @@ func NewKeyConfig(flagSet *pflag.FlagSet, encrypt bool) (*KeyConfig, error) {
 		opConfig = nil
 	}
 
-	return &KeyConfig{
+	keyConfig := &KeyConfig{
 		GlobalConfig:    globalConfig,
 		Mnemonic:        mnemonic,
 		Accounts:        accounts,
+		Account:         account,
+		Changes:         changes,
+		StartIndex:      startIndex,
+		Purposes:        purposes,
+		Coins:           coins,
+		EVMChains:       evmChains,
+		CosmosChains:    cosmosChains,
+		Network:         network,
+		XRPTag:          xrpTag,
 		Name:            name,
 		EncryptMnemonic: encryptMnemonic,
 		Encrypt:         encrypt,
 		Compressed:      compressed,
 		OPConfig:        opConfig,
-	}, nil
+	}
+	if err := keyConfig.ExportOptions().Validate(); err != nil {
+		return nil, err
+	}
+	return keyConfig, nil
+}
+
+// ParseChange returns the change levels for external, internal or both chains
+func ParseChange(change string) ([]bip44.Index, error) {
+	switch change {
+	case "external":
+		return []bip44.Index{bip44.ChangeExternal}, nil
+	case "internal":
+		return []bip44.Index{bip44.ChangeInternal}, nil
+	case "both":
+		return []bip44.Index{bip44.ChangeExternal, bip44.ChangeInternal}, nil
+	}
+	return nil, fmt.Errorf("invalid change %q, expected external, internal or both", change)
+}
+
+// ParsePurposes returns the purposes for levels such as 44, 49, 84 and 86
+func ParsePurposes(levels []string) ([]bip44.Purpose, error) {
+	purposes := make([]bip44.Purpose, 0, len(levels))
+	for _, level := range levels {
+		n, err := strconv.ParseUint(level, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid purpose %q, expected 44, 49, 84 or 86", level)
+		}
+		purpose := bip44.Purpose(uint32(n) + bip44.Apostrophe)
+		if _, err := purpose.AddressType(); err != nil {
+			return nil, fmt.Errorf("invalid purpose %q, expected 44, 49, 84 or 86", level)
+		}
+		purposes = append(purposes, purpose)
+	}
+	return purposes, nil
+}
+
+// ParseCoins returns the coins for symbols such as btc and ltc
+func ParseCoins(symbols []string) ([]*bip44.Coin, error) {
+	coins := make([]*bip44.Coin, 0, len(symbols))
+	for _, symbol := range symbols {
+		coin, err := bip44.ParseCoin(symbol)
+		if err != nil {
+			return nil, err
+		}
+		coins = append(coins, coin)
+	}
+	return coins, nil
+}
+
+// ParseEVMChains returns the EVM chain presets for symbols such as eth and rsk
+func ParseEVMChains(symbols []string) ([]*bip44.EVMChain, error) {
+	chains := make([]*bip44.EVMChain, 0, len(symbols))
+	for _, symbol := range symbols {
+		chain, err := bip44.ParseEVMChain(symbol)
+		if err != nil {
+			return nil, err
+		}
+		chains = append(chains, chain)
+	}
+	return chains, nil
+}
+
+// ParseCosmosChains returns the Cosmos SDK chains for symbols such as osmosis or hrp:coin_type pairs such as axelar:118
+func ParseCosmosChains(symbols []string) ([]*bip44.CosmosChain, error) {
+	chains := make([]*bip44.CosmosChain, 0, len(symbols))
+	for _, symbol := range symbols {
+		chain, err := bip44.ParseCosmosChain(symbol)
+		if err != nil {
+			return nil, err
+		}
+		chains = append(chains, chain)
+	}
+	return chains, nil
+}
+
+// ExportOptions returns the part of the BIP44 tree selected by the config
+func (c KeyConfig) ExportOptions() bip44.ExportOptions {
+	return bip44.ExportOptions{
+		Accounts:   c.Accounts,
+		Account:    c.Account,
+		Changes:    c.Changes,
+		StartIndex: c.StartIndex,
+		Purposes:   c.Purposes,
+		Coins:      c.Coins,
+		EVMChains:  c.EVMChains,
+		Cosmos:     c.CosmosChains,
+		Network:    c.Network,
+		XRPTag:     c.XRPTag,
+		Compress:   c.Compressed,
+	}
 }
 
 func NewGenerateConfig(flagSet *pflag.FlagSet) (*GenerateConfig, error) {
@@ -189,3 +416,169 @@ func NewDecryptConfig(flagSet *pflag.FlagSet) (*DecryptConfig, error) {
 		GlobalConfig: globalConfig,
 	}, nil
 }
+
+func NewDeriveConfig(flagSet *pflag.FlagSet) (*DeriveConfig, error) {
+	globalConfig, err := NewGlobalConfig(flagSet)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonic, err := flagSet.GetString("mnemonic")
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := flagSet.GetString("path")
+	if err != nil {
+		return nil, err
+	}
+
+	networkName, err := flagSet.GetString("network")
+	if err != nil {
+		return nil, err
+	}
+	network, err := btc.ParseNetwork(networkName)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptMnemonic, err := flagSet.GetBool("encrypt-mnemonic")
+	if err != nil {
+		return nil, err
+	}
+
+	compressed, err := flagSet.GetBool("compressed")
+	if err != nil {
+		return nil, err
+	}
+
+	if mnemonic == "" {
+		return nil, fmt.Errorf("a mnemonic is required to derive a key")
+	}
+	if path == "" {
+		return nil, fmt.Errorf("a derivation path is required")
+	}
+	if encryptMnemonic && globalConfig.Password == "" {
+		return nil, fmt.Errorf("a password is required to decrypt the mnemonic")
+	}
+
+	return &DeriveConfig{
+		GlobalConfig:    globalConfig,
+		Mnemonic:        mnemonic,
+		Path:            path,
+		Network:         network,
+		EncryptMnemonic: encryptMnemonic,
+		Compressed:      compressed,
+	}, nil
+}
+
+func NewWatchConfig(flagSet *pflag.FlagSet) (*WatchConfig, error) {
+	globalConfig, err := NewGlobalConfig(flagSet)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedKey, err := flagSet.GetString("xpub")
+	if err != nil {
+		return nil, err
+	}
+
+	purposeLevel, err := flagSet.GetUint32("purpose")
+	if err != nil {
+		return nil, err
+	}
+
+	networkName, err := flagSet.GetString("network")
+	if err != nil {
+		return nil, err
+	}
+	// The network is read from the key version unless one is given
+	var network *btc.Network
+	if networkName != "" {
+		network, err = btc.ParseNetwork(networkName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	accounts, err := flagSet.GetInt("accounts")
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := flagSet.GetString("change")
+	if err != nil {
+		return nil, err
+	}
+	changes, err := ParseChange(change)
+	if err != nil {
+		return nil, err
+	}
+
+	startLevel, err := flagSet.GetUint32("start-index")
+	if err != nil {
+		return nil, err
+	}
+	startIndex, err := bip44.NewIndex(startLevel)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start index: %w", err)
+	}
+
+	name, err := flagSet.GetString("name")
+	if err != nil {
+		return nil, err
+	}
+
+	compressed, err := flagSet.GetBool("compressed")
+	if err != nil {
+		return nil, err
+	}
+
+	save, err := flagSet.GetBool("save")
+	if err != nil {
+		return nil, err
+	}
+
+	if extendedKey == "" {
+		return nil, fmt.Errorf("an extended public key is required")
+	}
+
+	var purpose bip44.Purpose
+	switch purposeLevel {
+	case 0:
+	case 44, 49, 84, 86:
+		purpose = bip44.Purpose(purposeLevel + bip44.Apostrophe)
+	default:
+		return nil, fmt.Errorf("invalid purpose %d, expected 44, 49, 84 or 86", purposeLevel)
+	}
+
+	opConfig, err := NewOPConfig(flagSet)
+	if err != nil {
+		return nil, err
+	}
+
+	if opConfig.ServiceAccountToken == "" || opConfig.VaultID == "" {
+		opConfig = nil
+	}
+
+	keyConfig := &KeyConfig{
+		GlobalConfig: globalConfig,
+		Accounts:     accounts,
+		Changes:      changes,
+		StartIndex:   startIndex,
+		Network:      network,
+		Name:         name,
+		Compressed:   compressed,
+		OPConfig:     opConfig,
+	}
+	if err := keyConfig.ExportOptions().Validate(); err != nil {
+		return nil, err
+	}
+
+	return &WatchConfig{
+		KeyConfig:   keyConfig,
+		ExtendedKey: extendedKey,
+		Purpose:     purpose,
+		Save:        save,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/pflag"
+
+	"key-gen/bip44"
+)
+
+func TestParseChange(t *testing.T) {
+	tests := []struct {
+		change  string
+		want    []bip44.Index
+		wantErr bool
+	}{
+		{"external", []bip44.Index{bip44.ChangeExternal}, false},
+		{"internal", []bip44.Index{bip44.ChangeInternal}, false},
+		{"both", []bip44.Index{bip44.ChangeExternal, bip44.ChangeInternal}, false},
+		{"", nil, true},
+		{"change", nil, true},
+	}
+	for _, test := range tests {
+		got, err := ParseChange(test.change)
+		if (err != nil) != test.wantErr {
+			t.Errorf("ParseChange(%q) error = %v, wantErr %v", test.change, err, test.wantErr)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ParseChange(%q) = %v, want %v", test.change, got, test.want)
+		}
+	}
+}
+
+// TestNewOPConfig checks that the 1Password flags of the running command take precedence over the OP_ environment
+func TestNewOPConfig(t *testing.T) {
+	t.Setenv("OP_SERVICE_ACCOUNT_TOKEN", "env-token")
+	t.Setenv("OP_VAULT_ID", "env-vault")
+
+	tests := []struct {
+		name  string
+		args  []string
+		token string
+		vault string
+	}{
+		{"environment", nil, "env-token", "env-vault"},
+		{"flags", []string{"--op-service-account-token", "flag-token", "--op-vault-id", "flag-vault"}, "flag-token", "flag-vault"},
+		{"vault flag", []string{"--op-vault-id", "flag-vault"}, "env-token", "flag-vault"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flagSet := pflag.NewFlagSet(test.name, pflag.ContinueOnError)
+			flagSet.String("op-service-account-token", "", "")
+			flagSet.String("op-vault-id", "", "")
+			if err := flagSet.Parse(test.args); err != nil {
+				t.Fatal(err)
+			}
+			config, err := NewOPConfig(flagSet)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if config.ServiceAccountToken != test.token || config.VaultID != test.vault {
+				t.Errorf("config = %s %s, want %s %s", config.ServiceAccountToken, config.VaultID, test.token, test.vault)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Package xrp
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package xrp
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/tyler-smith/go-bip32"
+
+	"key-gen/btc"
+)
+
+// XRP Ledger addresses use Base58Check with the Ripple alphabet, which orders the same 58 characters differently
+// https://xrpl.org/docs/concepts/accounts/addresses
+const (
+	bitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	rippleAlphabet  = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+)
+
+// AccountAddressID prefixes classic addresses, which always start with r
+const AccountAddressID byte = 0x00
+
+var toRipple = strings.NewReplacer(alphabetPairs()...)
+
+func alphabetPairs() []string {
+	pairs := make([]string, 0, 2*len(bitcoinAlphabet))
+	for i := range bitcoinAlphabet {
+		pairs = append(pairs, bitcoinAlphabet[i:i+1], rippleAlphabet[i:i+1])
+	}
+	return pairs
+}
+
+// Network holds the X-address prefix of an XRP Ledger network, classic addresses are the same on every network
+// XLS-5d : Tagged Addresses https://github.com/XRPLF/XRPL-Standards/tree/master/XLS-0005-tagged-addresses
+// Classic addresses come out of its bip44.Chain methods and X-addresses out of bip44.TaggedAddresser
+type Network struct {
+	Name       string
+	XAddressID [2]byte
+}
+
+var (
+	Mainnet = &Network{"mainnet", [2]byte{0x05, 0x44}} // X
+	Testnet = &Network{"testnet", [2]byte{0x04, 0x93}} // T
+)
+
+// CheckEncode returns the Base58Check encoding of version || payload in the Ripple alphabet
+// The checksum is the first 4 bytes of SHA256(SHA256(version || payload)), as in Bitcoin
+func CheckEncode(payload []byte, version []byte) string {
+	b := make([]byte, 0, len(version)+len(payload)+4)
+	b = append(b, version...)
+	b = append(b, payload...)
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	b = append(b, second[:4]...)
+	return toRipple.Replace(base58.Encode(b))
+}
+
+// AccountID returns the account id of a secp256k1 key, RIPEMD160(SHA256(compressed public key))
+func AccountID(pubKey *btcec.PublicKey) []byte {
+	return btcutil.Hash160(pubKey.SerializeCompressed())
+}
+
+// EncodeClassicAddress returns the classic r-address of an account id
+func EncodeClassicAddress(accountID []byte) string {
+	return CheckEncode(accountID, []byte{AccountAddressID})
+}
+
+// EncodeXAddress returns the X-address of an account id, packing the destination tag when one is given
+// The payload is the account id, a flag byte set to 1 when a tag is present and the tag as 8 little-endian bytes
+func (n *Network) EncodeXAddress(accountID []byte, tag *uint32) string {
+	payload := make([]byte, len(accountID)+9)
+	copy(payload, accountID)
+	if tag != nil {
+		payload[len(accountID)] = 1
+		binary.LittleEndian.PutUint32(payload[len(accountID)+1:], *tag)
+	}
+	return CheckEncode(payload, n.XAddressID[:])
+}
+
+func (n *Network) Supports(addressType btc.AddressType) bool {
+	return addressType == btc.AddressTypeP2PKH
+}
+
+// Label returns the name of the classic address encoding
+func (n *Network) Label(addressType btc.AddressType, compress bool) string {
+	return "Classic(r-address)"
+}
+
+// TaggedLabel returns the name of the X-address encoding and its destination tag
+func (n *Network) TaggedLabel(tag *uint32) string {
+	if tag == nil {
+		return "X-address"
+	}
+	return fmt.Sprintf("X-address(tag %d)", *tag)
+}
+
+// TaggedAddress returns the X-address of the public key, packing the destination tag when one is given
+func (n *Network) TaggedAddress(pubKey *btcec.PublicKey, tag *uint32) (string, error) {
+	return n.EncodeXAddress(AccountID(pubKey), tag), nil
+}
+
+// Address returns the classic address of the public key
+// XRP accounts always hash the compressed key, so compress does not change the address
+func (n *Network) Address(pubKey *btcec.PublicKey, compress bool, addressType btc.AddressType) (string, error) {
+	if !n.Supports(addressType) {
+		return "", fmt.Errorf("%s is not supported on XRP", addressType)
+	}
+	return EncodeClassicAddress(AccountID(pubKey)), nil
+}
+
+// PrivateKey returns the private key as hex
+func (n *Network) PrivateKey(prvKey *btcec.PrivateKey, compress bool) (string, error) {
+	return fmt.Sprintf("%x", prvKey.Serialize()), nil
+}
+
+func (n *Network) PrivateKeyFormat() string {
+	return "Private Key(hex)"
+}
+
+func (n *Network) ExtendedPublicKey(key *bip32.Key, addressType btc.AddressType) string {
+	return ""
+}
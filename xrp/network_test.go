@@ -0,0 +1,90 @@
+package xrp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/tyler-smith/go-bip32"
+	"github.com/tyler-smith/go-bip39"
+
+	"key-gen/btc"
+)
+
+// decodeClassicAddress returns the account id of a classic address
+func decodeClassicAddress(t *testing.T, address string) []byte {
+	t.Helper()
+	pairs := alphabetPairs()
+	for i := 0; i < len(pairs); i += 2 {
+		pairs[i], pairs[i+1] = pairs[i+1], pairs[i]
+	}
+	accountID, version, err := base58.CheckDecode(strings.NewReplacer(pairs...).Replace(address))
+	if err != nil || version != AccountAddressID {
+		t.Fatalf("decoding %s: version %d, error %v", address, version, err)
+	}
+	return accountID
+}
+
+func TestEncodeClassicAddress(t *testing.T) {
+	tests := []string{
+		"rrrrrrrrrrrrrrrrrrrrrhoLvTp", // ACCOUNT_ZERO, the all zero account id
+		"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59",
+	}
+	for _, want := range tests {
+		if got := EncodeClassicAddress(decodeClassicAddress(t, want)); got != want {
+			t.Errorf("EncodeClassicAddress() = %s, want %s", got, want)
+		}
+	}
+}
+
+// TestEncodeXAddress checks the XLS-5d test vectors
+// https://github.com/XRPLF/XRPL-Standards/tree/master/XLS-0005-tagged-addresses#test-vectors
+func TestEncodeXAddress(t *testing.T) {
+	accountID := decodeClassicAddress(t, "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59")
+	tag := func(tag uint32) *uint32 { return &tag }
+	tests := []struct {
+		network *Network
+		tag     *uint32
+		want    string
+	}{
+		{Mainnet, nil, "X7AcgcsBL6XDcUb289X4mJ8djcdyKaB5hJDWMArnXr61cqZ"},
+		{Mainnet, tag(1), "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGZMhc9YTE92ehJ2Fu"},
+		{Mainnet, tag(14), "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGo2K5VpXpmCqbV2gS"},
+		{Mainnet, tag(11747), "X7AcgcsBL6XDcUb289X4mJ8djcdyKaLFuhLRuNXPrDeJd9A"},
+		{Testnet, nil, "T719a5UwUCnEs54UsxG9CJYYDhwmFCqkr7wxCcNcfZ6p5GZ"},
+		{Testnet, tag(1), "T719a5UwUCnEs54UsxG9CJYYDhwmFCvbJNZbi37gBGkRkbE"},
+	}
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			if got := test.network.EncodeXAddress(accountID, test.tag); got != test.want {
+				t.Errorf("EncodeXAddress() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+// TestAddress checks the first XRP address of the abandon about mnemonic at m/44'/144'/0'/0/0
+func TestAddress(t *testing.T) {
+	key, err := bip32.NewMasterKey(bip39.NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range []uint32{bip32.FirstHardenedChild + 44, bip32.FirstHardenedChild + 144, bip32.FirstHardenedChild, 0, 0} {
+		key, err = key.NewChildKey(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	pubKey, err := btcec.ParsePubKey(key.PublicKey().Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	address, err := Mainnet.Address(pubKey, true, btc.AddressTypeP2PKH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "rHsMGQEkVNJmpGWs8XUBoTBiAAbwxZN5v3"; address != want {
+		t.Errorf("address = %s, want %s", address, want)
+	}
+}
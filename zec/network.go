@@ -0,0 +1,70 @@
+// Package zec
+/*
+Copyright © 2024 Evan Owen <admin@ulmentflam.com>
+*/
+package zec
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/base58"
+
+	"key-gen/btc"
+)
+
+// Network is the bip44.Chain of Zcash transparent keys, which share the Bitcoin WIF and extended key versions
+// Transparent addresses use two-byte Base58Check version prefixes
+// https://zips.z.cash/protocol/protocol.pdf#transparentaddrencoding
+type Network struct {
+	*btc.Network
+	PubKeyHashAddrID [2]byte
+}
+
+var (
+	Mainnet = &Network{btc.Mainnet, [2]byte{0x1c, 0xb8}} // t1
+	Testnet = &Network{btc.Testnet, [2]byte{0x1d, 0x25}} // tm
+)
+
+// CheckEncode returns the Base58Check encoding of the payload prefixed with a two-byte version
+func CheckEncode(payload []byte, version [2]byte) string {
+	b := make([]byte, 0, len(version)+len(payload)+4)
+	b = append(b, version[:]...)
+	b = append(b, payload...)
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	b = append(b, second[:4]...)
+	return base58.Encode(b)
+}
+
+// EncodePubKeyHash returns the P2PKH transparent address of a public key hash, t1 on mainnet
+func (n *Network) EncodePubKeyHash(pubKeyHash []byte) string {
+	return CheckEncode(pubKeyHash, n.PubKeyHashAddrID)
+}
+
+// Supports reports whether the chain can spend the address type, Zcash has no SegWit
+func (n *Network) Supports(addressType btc.AddressType) bool {
+	return addressType == btc.AddressTypeP2PKH
+}
+
+// Label returns the label of the address type, noting whether the key is compressed
+func (n *Network) Label(addressType btc.AddressType, compress bool) string {
+	if compress {
+		return "Transparent(P2PKH, compressed)"
+	}
+	return "Transparent(P2PKH, uncompressed)"
+}
+
+// Address returns the transparent address paying to the public key
+func (n *Network) Address(pubKey *btcec.PublicKey, compress bool, addressType btc.AddressType) (string, error) {
+	if !n.Supports(addressType) {
+		return "", fmt.Errorf("%s is not supported on Zcash", addressType)
+	}
+	serializedPubKey := pubKey.SerializeUncompressed()
+	if compress {
+		serializedPubKey = pubKey.SerializeCompressed()
+	}
+	return n.EncodePubKeyHash(btcutil.Hash160(serializedPubKey)), nil
+}
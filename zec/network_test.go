@@ -0,0 +1,49 @@
+package zec
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/base58"
+
+	"key-gen/btc"
+)
+
+// TestAddress checks transparent addresses against the btcutil Base58Check encoding with the two-byte version
+// split over its version byte and payload, and their t1 and tm prefixes
+func TestAddress(t *testing.T) {
+	_, pubKey := btcec.PrivKeyFromBytes(bytes.Repeat([]byte{0x01}, 32))
+	tests := []struct {
+		network  *Network
+		compress bool
+		prefix   string
+	}{
+		{Mainnet, true, "t1"},
+		{Mainnet, false, "t1"},
+		{Testnet, true, "tm"},
+	}
+	for _, test := range tests {
+		address, err := test.network.Address(pubKey, test.compress, btc.AddressTypeP2PKH)
+		if err != nil {
+			t.Fatal(err)
+		}
+		serialized := pubKey.SerializeUncompressed()
+		if test.compress {
+			serialized = pubKey.SerializeCompressed()
+		}
+		version := test.network.PubKeyHashAddrID
+		want := base58.CheckEncode(append([]byte{version[1]}, btcutil.Hash160(serialized)...), version[0])
+		if address != want {
+			t.Errorf("%s address = %s, want %s", test.network.Name, address, want)
+		}
+		if !strings.HasPrefix(address, test.prefix) {
+			t.Errorf("%s address = %s, want prefix %s", test.network.Name, address, test.prefix)
+		}
+	}
+	if _, err := Mainnet.Address(pubKey, true, btc.AddressTypeP2WPKH); err == nil {
+		t.Error("P2WPKH address derived on Zcash")
+	}
+}